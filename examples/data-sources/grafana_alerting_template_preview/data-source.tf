@@ -0,0 +1,21 @@
+data "grafana_alerting_template_preview" "test" {
+  name     = "my-template"
+  template = <<-EOT
+    {{ define "custom.title" }}{{ len .Alerts.Firing }} firing: {{ .CommonLabels.alertname }}{{ end }}
+  EOT
+
+  alert {
+    labels = {
+      alertname = "HighLatency"
+      team      = "backend"
+    }
+    annotations = {
+      summary = "Latency is over 1s"
+    }
+  }
+}
+
+resource "grafana_message_template" "my_template" {
+  name     = data.grafana_alerting_template_preview.test.name
+  template = data.grafana_alerting_template_preview.test.template
+}
@@ -0,0 +1,13 @@
+resource "grafana_annotation" "deployment" {
+  text = "Deployed version 1.2.3"
+  time = "2024-01-15T10:00:00Z"
+  tags = ["deployment", "my-service"]
+}
+
+data "grafana_annotations" "deployments" {
+  tags = ["deployment", "my-service"]
+  from = "2024-01-01T00:00:00Z"
+  to   = "2024-02-01T00:00:00Z"
+
+  depends_on = [grafana_annotation.deployment]
+}
@@ -0,0 +1,8 @@
+data "grafana_cloud_org_members" "admins" {
+  org         = "<your org slug>"
+  role_filter = "Admin"
+}
+
+output "admin_emails" {
+  value = [for member in data.grafana_cloud_org_members.admins.members : member.email]
+}
@@ -0,0 +1,7 @@
+data "grafana_cloud_stacks" "all" {
+  org_slug_filter = "<your org slug>"
+}
+
+output "stack_urls" {
+  value = { for stack in data.grafana_cloud_stacks.all.stacks : stack.slug => stack.url }
+}
@@ -16,6 +16,13 @@ resource "grafana_dashboard" "data_source_dashboards1" {
     uid   = "data-source-dashboards-1"
     title = "data_source_dashboards 1"
     tags  = ["dev"]
+    panels = [
+      {
+        id         = 1
+        type       = "timeseries"
+        datasource = { type = "prometheus", uid = "data-source-dashboards-prometheus" }
+      }
+    ]
   })
 }
 
@@ -45,6 +52,24 @@ data "grafana_dashboards" "folder_uids_tags" {
   tags        = jsondecode(grafana_dashboard.data_source_dashboards1.config_json)["tags"]
 }
 
+data "grafana_dashboards" "datasource_uids" {
+  org_id          = grafana_organization.test.id
+  datasource_uids = ["data-source-dashboards-prometheus"]
+  depends_on = [
+    grafana_dashboard.data_source_dashboards1,
+    grafana_dashboard.data_source_dashboards2
+  ]
+}
+
+data "grafana_dashboards" "panel_types" {
+  org_id      = grafana_organization.test.id
+  panel_types = ["timeseries"]
+  depends_on = [
+    grafana_dashboard.data_source_dashboards1,
+    grafana_dashboard.data_source_dashboards2
+  ]
+}
+
 // use depends_on to wait for dashboard resource to be created before searching
 data "grafana_dashboards" "all" {
   org_id = grafana_organization.test.id
@@ -0,0 +1,33 @@
+resource "grafana_data_source" "prometheus_a" {
+  type = "prometheus"
+  name = "prometheus-ds-a"
+  url  = "http://prometheus-a.example.net:9090"
+}
+
+resource "grafana_data_source" "prometheus_b" {
+  type = "prometheus"
+  name = "prometheus-ds-b"
+  url  = "http://prometheus-b.example.net:9090"
+}
+
+resource "grafana_data_source" "loki" {
+  type = "loki"
+  name = "loki-ds"
+  url  = "http://loki.example.net:3100"
+}
+
+data "grafana_data_sources" "prometheus" {
+  type = "prometheus"
+  name = "prometheus-ds-*"
+
+  depends_on = [
+    grafana_data_source.prometheus_a,
+    grafana_data_source.prometheus_b,
+    grafana_data_source.loki,
+  ]
+}
+
+// The data sources can be used with for_each, eg. to manage their permissions
+output "prometheus_data_source_uids" {
+  value = { for ds in data.grafana_data_sources.prometheus.data_sources : ds.name => ds.uid }
+}
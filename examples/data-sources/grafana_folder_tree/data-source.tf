@@ -0,0 +1,41 @@
+resource "grafana_folder" "parent" {
+  title = "folder-tree-parent"
+  uid   = "folder-tree-parent"
+}
+
+resource "grafana_folder" "child" {
+  title             = "folder-tree-child"
+  uid               = "folder-tree-child"
+  parent_folder_uid = grafana_folder.parent.uid
+}
+
+resource "grafana_folder" "grandchild" {
+  title             = "folder-tree-grandchild"
+  uid               = "folder-tree-grandchild"
+  parent_folder_uid = grafana_folder.child.uid
+}
+
+// List all subfolders of the parent folder, at any depth
+data "grafana_folder_tree" "subtree" {
+  root_folder_uid = grafana_folder.parent.uid
+  depends_on = [
+    grafana_folder.grandchild,
+  ]
+}
+
+// List only the direct subfolders of the parent folder
+data "grafana_folder_tree" "direct_children" {
+  root_folder_uid = grafana_folder.parent.uid
+  max_depth       = 0
+  depends_on = [
+    grafana_folder.grandchild,
+  ]
+}
+
+// List the subfolders of a nested folder. Their parent_uids also include the ancestors of the root folder
+data "grafana_folder_tree" "nested" {
+  root_folder_uid = grafana_folder.child.uid
+  depends_on = [
+    grafana_folder.grandchild,
+  ]
+}
@@ -0,0 +1,30 @@
+resource "grafana_library_panel" "test" {
+  name = "panelname"
+  model_json = jsonencode({
+    title   = "test name"
+    type    = "text"
+    version = 0
+  })
+}
+
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    title = "panelname Dashboard"
+    uid   = "panelname-dashboard"
+    panels = [
+      {
+        id      = 1
+        gridPos = { x = 0, y = 0, h = 10, w = 10 }
+        libraryPanel = {
+          uid  = grafana_library_panel.test.uid
+          name = grafana_library_panel.test.name
+        }
+      }
+    ]
+  })
+}
+
+data "grafana_library_panel_connections" "test" {
+  library_panel_uid = grafana_library_panel.test.uid
+  depends_on        = [grafana_dashboard.test]
+}
@@ -0,0 +1,14 @@
+data "grafana_machine_learning_jobs" "active_users" {
+  metric = "tf_test_job"
+}
+
+// Alert on the anomalies of each job exposing the metric
+resource "grafana_machine_learning_alert" "anomaly" {
+  for_each = toset(data.grafana_machine_learning_jobs.active_users.ids)
+
+  job_id            = each.value
+  title             = "Anomaly of job ${each.value}"
+  anomaly_condition = "any"
+  threshold         = ">0.8"
+  window            = "15m"
+}
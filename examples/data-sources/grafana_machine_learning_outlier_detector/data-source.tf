@@ -0,0 +1,7 @@
+data "grafana_machine_learning_outlier_detector" "by_name" {
+  name = "My MAD outlier detector"
+}
+
+data "grafana_machine_learning_outlier_detector" "by_metric" {
+  metric = "tf_test_mad_job"
+}
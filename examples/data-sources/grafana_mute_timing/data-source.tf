@@ -0,0 +1,16 @@
+resource "grafana_mute_timing" "test" {
+  name = "Mute Timing Data Source"
+
+  intervals {
+    times {
+      start = "04:56"
+      end   = "14:17"
+    }
+    weekdays = ["monday", "tuesday:thursday"]
+    location = "America/New_York"
+  }
+}
+
+data "grafana_mute_timing" "from_name" {
+  name = grafana_mute_timing.test.name
+}
@@ -1,3 +1,14 @@
 data "grafana_oncall_schedule" "schedule" {
   name = "example_schedule"
-}
\ No newline at end of file
+}
+# Notify the users that are currently on call
+resource "grafana_oncall_escalation_chain" "default" {
+  name = "default"
+}
+
+resource "grafana_oncall_escalation" "notify_current_on_call" {
+  escalation_chain_id = grafana_oncall_escalation_chain.default.id
+  type                = "notify_persons"
+  persons_to_notify   = data.grafana_oncall_schedule.schedule.on_call_now
+  position            = 0
+}
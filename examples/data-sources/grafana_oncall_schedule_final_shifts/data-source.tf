@@ -0,0 +1,13 @@
+data "grafana_oncall_schedule" "schedule" {
+  name = "example_schedule"
+}
+
+data "grafana_oncall_schedule_final_shifts" "next_week" {
+  schedule_id = data.grafana_oncall_schedule.schedule.id
+  start_date  = "2024-06-03"
+  end_date    = "2024-06-09"
+}
+
+output "on_call_usernames" {
+  value = distinct(data.grafana_oncall_schedule_final_shifts.next_week.shifts[*].user_username)
+}
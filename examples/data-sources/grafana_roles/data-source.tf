@@ -0,0 +1,34 @@
+resource "grafana_role" "test" {
+  name        = "custom:test-roles-ds:reader"
+  description = "test-roles-ds description"
+  uid         = "test-roles-ds-uid"
+  version     = 1
+  global      = true
+  group       = "Test Roles"
+
+  permissions {
+    action = "org.users:read"
+    scope  = "users:*"
+  }
+}
+
+data "grafana_roles" "custom" {
+  name_prefix = "custom:test-roles-ds:"
+  depends_on  = [grafana_role.test]
+}
+
+data "grafana_roles" "fixed_dashboards" {
+  name_prefix = "fixed:dashboards:"
+}
+
+# Assign all the fixed dashboard roles to a team
+resource "grafana_team" "dashboard_admins" {
+  name = "Dashboard Admins"
+}
+
+resource "grafana_role_assignment_item" "dashboard_admins" {
+  for_each = { for role in data.grafana_roles.fixed_dashboards.roles : role.uid => role }
+
+  role_uid = each.key
+  team_id  = grafana_team.dashboard_admins.id
+}
@@ -43,4 +43,9 @@ resource "grafana_slo" "test" {
   }
 }
 
-data "grafana_slos" "slos" {}
\ No newline at end of file
+data "grafana_slos" "slos" {}
+data "grafana_slos" "filtered" {
+  label_filter = {
+    custom = "value"
+  }
+}
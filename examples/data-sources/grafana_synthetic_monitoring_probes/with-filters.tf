@@ -0,0 +1,15 @@
+data "grafana_synthetic_monitoring_probes" "emea_public" {
+  filter_region     = "EMEA"
+  filter_visibility = "public"
+}
+
+resource "grafana_synthetic_monitoring_check" "ping" {
+  job     = "Ping Default"
+  target  = "grafana.com"
+  enabled = false
+  probes  = data.grafana_synthetic_monitoring_probes.emea_public.ids
+  labels  = {}
+  settings {
+    ping {}
+  }
+}
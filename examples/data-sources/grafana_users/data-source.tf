@@ -10,3 +10,11 @@ data "grafana_users" "all_users" {
     grafana_user.test_all_users,
   ]
 }
+
+data "grafana_users" "filtered_users" {
+  query = "test-grafana-users"
+  role  = "Viewer"
+  depends_on = [
+    grafana_user.test_all_users,
+  ]
+}
@@ -0,0 +1,2 @@
+terraform import grafana_alerting_admin_config.name "{{ anyString }}"
+terraform import grafana_alerting_admin_config.name "{{ orgID }}:{{ anyString }}"
@@ -0,0 +1,15 @@
+resource "grafana_data_source" "external_alertmanager" {
+  type = "alertmanager"
+  name = "external-alertmanager"
+  url  = "http://alertmanager.example.com:9093"
+  json_data_encoded = jsonencode({
+    implementation             = "prometheus"
+    handleGrafanaManagedAlerts = true
+  })
+}
+
+resource "grafana_alerting_admin_config" "config" {
+  alertmanagers_choice = "external"
+
+  depends_on = [grafana_data_source.external_alertmanager]
+}
@@ -0,0 +1,2 @@
+terraform import grafana_alerting_silence.name "{{ id }}"
+terraform import grafana_alerting_silence.name "{{ orgID }}:{{ id }}"
@@ -0,0 +1,29 @@
+// A silence for a planned maintenance window
+resource "grafana_alerting_silence" "maintenance" {
+  comment   = "Database maintenance"
+  starts_at = "2099-01-01T02:00:00Z"
+  ends_at   = "2099-01-01T04:00:00Z"
+
+  matcher {
+    label = "team"
+    match = "="
+    value = "database"
+  }
+  matcher {
+    label = "severity"
+    match = "!~"
+    value = "critical|page"
+  }
+}
+
+// A silence starting now, for two hours
+resource "grafana_alerting_silence" "now" {
+  comment  = "Investigating flapping alerts"
+  duration = "2h"
+
+  matcher {
+    label = "alertname"
+    match = "="
+    value = "FlappingAlert"
+  }
+}
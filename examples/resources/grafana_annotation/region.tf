@@ -0,0 +1,6 @@
+resource "grafana_annotation" "maintenance" {
+  text     = "Database maintenance"
+  time     = "2024-01-15T10:00:00+01:00"
+  time_end = "2024-01-15T12:30:00+01:00"
+  tags     = ["maintenance"]
+}
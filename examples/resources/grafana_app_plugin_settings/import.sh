@@ -0,0 +1,2 @@
+terraform import grafana_app_plugin_settings.name "{{ pluginID }}"
+terraform import grafana_app_plugin_settings.name "{{ orgID }}:{{ pluginID }}"
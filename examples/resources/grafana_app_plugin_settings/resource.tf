@@ -0,0 +1,11 @@
+resource "grafana_app_plugin_settings" "oncall" {
+  plugin_id = "grafana-oncall-app"
+  enabled   = true
+  pinned    = true
+  json_data_encoded = jsonencode({
+    onCallApiUrl = "https://oncall-prod-us-central-0.grafana.net/oncall"
+  })
+  secure_json_data_encoded = jsonencode({
+    onCallApiToken = "my-token"
+  })
+}
@@ -17,6 +17,10 @@ resource "grafana_cloud_access_policy" "test" {
       selector = "{namespace=\"default\"}"
     }
   }
+
+  conditions {
+    allowed_subnets = ["10.0.0.0/8", "192.168.1.0/24"]
+  }
 }
 
 resource "grafana_cloud_access_policy_token" "test" {
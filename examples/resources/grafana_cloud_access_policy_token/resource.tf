@@ -26,3 +26,12 @@ resource "grafana_cloud_access_policy_token" "test" {
   display_name     = "My Policy Token"
   expires_at       = "2023-01-01T00:00:00Z"
 }
+
+# Regenerated every 30 days, a week before it expires
+resource "grafana_cloud_access_policy_token" "rotating" {
+  region           = "us"
+  access_policy_id = grafana_cloud_access_policy.test.policy_id
+  name             = "my-rotating-token"
+  expire_after     = "888h" # 37 days
+  rotate_after     = "720h" # 30 days
+}
@@ -0,0 +1,23 @@
+data "grafana_cloud_stack" "current" {
+  slug = "<your stack slug>"
+}
+
+resource "grafana_cloud_private_data_source_connect_network" "test" {
+  region           = "us"
+  name             = "my-pdc"
+  display_name     = "My PDC"
+  stack_identifier = data.grafana_cloud_stack.current.id
+}
+
+resource "grafana_cloud_private_data_source_connect_network_token" "test" {
+  region         = grafana_cloud_private_data_source_connect_network.test.region
+  pdc_network_id = grafana_cloud_private_data_source_connect_network.test.pdc_network_id
+  name           = "my-pdc-token"
+  display_name   = "My PDC Token"
+}
+
+# The flags of the PDC agent
+output "pdc_agent_flags" {
+  value     = "-cluster ${grafana_cloud_private_data_source_connect_network.test.cluster} -gcloud-hosted-grafana-id ${grafana_cloud_private_data_source_connect_network.test.hosted_grafana_id} -token ${grafana_cloud_private_data_source_connect_network_token.test.token}"
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import grafana_cloud_private_data_source_connect_network_token.name "{{ region }}:{{ tokenId }}"
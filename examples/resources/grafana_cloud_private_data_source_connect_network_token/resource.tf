@@ -0,0 +1,12 @@
+resource "grafana_cloud_private_data_source_connect_network" "test" {
+  region           = "us"
+  name             = "my-pdc"
+  stack_identifier = "<your stack ID>"
+}
+
+resource "grafana_cloud_private_data_source_connect_network_token" "test" {
+  region         = grafana_cloud_private_data_source_connect_network.test.region
+  pdc_network_id = grafana_cloud_private_data_source_connect_network.test.pdc_network_id
+  name           = "my-pdc-token"
+  display_name   = "My PDC Token"
+}
@@ -0,0 +1,22 @@
+resource "grafana_contact_point" "receiver_types" {
+  name = "Receiver Types since v11.3"
+
+  mqtt {
+    broker_url           = "tcp://localhost:1883"
+    topic                = "grafana/alerts"
+    client_id            = "grafana"
+    message_format       = "json"
+    username             = "user"
+    password             = "password"
+    qos                  = 1
+    retain               = true
+    insecure_skip_verify = true
+  }
+
+  teams_workflows {
+    url           = "http://teams-workflows-webhook"
+    message       = "message"
+    title         = "title"
+    section_title = "section"
+  }
+}
@@ -0,0 +1,16 @@
+resource "grafana_dashboard_snapshot" "incident" {
+  name    = "Incident snapshot"
+  expires = 86400 # 1 day
+  config_json = jsonencode({
+    title = "Incident dashboard"
+    panels = [
+      {
+        id      = 1
+        type    = "text"
+        title   = "Summary"
+        gridPos = { x = 0, y = 0, h = 8, w = 12 }
+        options = { content = "The database was unreachable from 10:00 to 10:15 UTC." }
+      }
+    ]
+  })
+}
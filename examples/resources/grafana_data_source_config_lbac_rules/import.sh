@@ -0,0 +1,2 @@
+terraform import grafana_data_source_config_lbac_rules.name "{{ datasourceUID }}"
+terraform import grafana_data_source_config_lbac_rules.name "{{ orgID }}:{{ datasourceUID }}"
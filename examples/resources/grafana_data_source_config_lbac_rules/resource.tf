@@ -0,0 +1,30 @@
+resource "grafana_team" "dev" {
+  name = "Developers"
+}
+
+resource "grafana_team" "ops" {
+  name = "Operators"
+}
+
+resource "grafana_data_source" "loki" {
+  type = "loki"
+  name = "loki-lbac"
+  url  = "http://localhost:3100"
+}
+
+resource "grafana_data_source_config_lbac_rules" "loki" {
+  datasource_uid = grafana_data_source.loki.uid
+
+  team {
+    team_id = grafana_team.dev.id
+    rules   = ["{ namespace=\"dev\" }"]
+  }
+
+  team {
+    team_id = grafana_team.ops.id
+    rules = [
+      "{ namespace=~\"prod|staging\" }",
+      "{ app=\"ingress\", cluster!=\"internal\" }",
+    ]
+  }
+}
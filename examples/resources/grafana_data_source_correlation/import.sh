@@ -0,0 +1,2 @@
+terraform import grafana_data_source_correlation.name "{{ sourceUID }}:{{ correlationUID }}"
+terraform import grafana_data_source_correlation.name "{{ orgID }}:{{ sourceUID }}:{{ correlationUID }}"
@@ -0,0 +1,32 @@
+resource "grafana_data_source" "loki" {
+  type = "loki"
+  name = "loki-correlation-source"
+  url  = "http://loki.example.net:3100"
+}
+
+resource "grafana_data_source" "tempo" {
+  type = "tempo"
+  name = "tempo-correlation-target"
+  url  = "http://tempo.example.net:3200"
+}
+
+resource "grafana_data_source_correlation" "logs_to_traces" {
+  source_uid  = grafana_data_source.loki.uid
+  target_uid  = grafana_data_source.tempo.uid
+  label       = "Trace"
+  description = "Open the trace of the log line"
+
+  config {
+    field = "traceID"
+    target_json = jsonencode({
+      query = "$${traceID}"
+    })
+
+    transformation {
+      type       = "regex"
+      field      = "line"
+      expression = "traceID=(\\w+)"
+      map_value  = "traceID"
+    }
+  }
+}
@@ -48,8 +48,8 @@ resource "grafana_data_source_permission_item" "role" {
 }
 
 resource "grafana_data_source_permission_item" "service_account" {
-  datasource_uid = grafana_data_source.foo.uid
-  user           = grafana_service_account.sa.id
-  permission     = "Query"
+  datasource_uid  = grafana_data_source.foo.uid
+  service_account = grafana_service_account.sa.id
+  permission      = "Query"
 }
 
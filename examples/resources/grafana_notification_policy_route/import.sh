@@ -0,0 +1,2 @@
+terraform import grafana_notification_policy_route.name "{{ matchers }}"
+terraform import grafana_notification_policy_route.name "{{ orgID }}:{{ matchers }}"
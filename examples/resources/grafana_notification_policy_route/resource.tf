@@ -0,0 +1,32 @@
+resource "grafana_contact_point" "backend" {
+  name = "Backend Team"
+
+  email {
+    addresses = ["backend@company.org"]
+  }
+}
+
+resource "grafana_notification_policy_route" "backend" {
+  contact_point = grafana_contact_point.backend.name
+  group_by      = ["alertname"]
+
+  matcher {
+    label = "team"
+    match = "="
+    value = "backend"
+  }
+
+  group_wait      = "45s"
+  group_interval  = "6m"
+  repeat_interval = "3h"
+
+  policy {
+    contact_point = grafana_contact_point.backend.name
+    matcher {
+      label = "severity"
+      match = "=~"
+      value = "critical|page"
+    }
+    repeat_interval = "1h"
+  }
+}
@@ -2,3 +2,26 @@ resource "grafana_oncall_escalation_chain" "default" {
   provider = grafana.oncall
   name     = "default"
 }
+
+# The steps of the chain can be managed inline, in order
+data "grafana_oncall_team" "my_team" {
+  provider = grafana.oncall
+  name     = "my team"
+}
+
+resource "grafana_oncall_escalation_chain" "with_steps" {
+  provider = grafana.oncall
+  name     = "with steps"
+
+  step {
+    type                   = "notify_team_members"
+    notify_to_team_members = data.grafana_oncall_team.my_team.id
+  }
+  step {
+    type     = "wait"
+    duration = 300
+  }
+  step {
+    type = "repeat_escalation"
+  }
+}
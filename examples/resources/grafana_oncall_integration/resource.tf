@@ -26,3 +26,34 @@ resource "grafana_oncall_integration" "integration_with_templates" {
     }
   }
 }
+
+# The direct paging settings of a team ("page this team") are managed with a direct_paging integration.
+# Each team can have a single direct paging integration.
+data "grafana_oncall_team" "my_team" {
+  provider = grafana.oncall
+  name     = "my team"
+}
+
+data "grafana_oncall_slack_channel" "my_team_channel" {
+  provider = grafana.oncall
+  name     = "my-team-alerts"
+}
+
+resource "grafana_oncall_escalation_chain" "my_team" {
+  provider = grafana.oncall
+  name     = "my team"
+  team_id  = data.grafana_oncall_team.my_team.id
+}
+
+resource "grafana_oncall_integration" "my_team_direct_paging" {
+  provider = grafana.oncall
+  name     = "Direct paging (my team)"
+  type     = "direct_paging"
+  team_id  = data.grafana_oncall_team.my_team.id
+  default_route {
+    escalation_chain_id = grafana_oncall_escalation_chain.my_team.id
+    slack {
+      channel_id = data.grafana_oncall_slack_channel.my_team_channel.slack_id
+    }
+  }
+}
@@ -0,0 +1,14 @@
+resource "grafana_oncall_integration" "example" {
+  provider = grafana.oncall
+  name     = "my integration"
+  type     = "grafana"
+  default_route {
+  }
+}
+
+resource "grafana_oncall_integration_maintenance" "planned_upgrade" {
+  provider       = grafana.oncall
+  integration_id = grafana_oncall_integration.example.id
+  mode           = "maintenance"
+  duration       = "3h"
+}
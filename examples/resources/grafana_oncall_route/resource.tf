@@ -31,3 +31,12 @@ resource "grafana_oncall_route" "example_route" {
     enabled = false
   }
 }
+
+# Routes can match on the labels of the alert group with a Jinja2 template
+resource "grafana_oncall_route" "example_label_route" {
+  integration_id      = grafana_oncall_integration.example_integration.id
+  escalation_chain_id = grafana_oncall_escalation_chain.default.id
+  routing_type        = "jinja2"
+  routing_regex       = "{{ labels.severity == \"critical\" and labels.team == \"payments\" }}"
+  position            = 1
+}
@@ -1,3 +1,9 @@
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    title = "Terraform Playlist Dashboard"
+  })
+}
+
 resource "grafana_playlist" "test" {
   name     = "My Playlist!"
   interval = "5m"
@@ -12,9 +18,8 @@ resource "grafana_playlist" "test" {
   }
 
   item {
-    order = 1
-    title = "Terraform Dashboard By ID"
-    type  = "dashboard_by_id"
-    value = "3"
+    order         = 1
+    title         = "Terraform Dashboard By UID"
+    dashboard_uid = grafana_dashboard.test.uid
   }
 }
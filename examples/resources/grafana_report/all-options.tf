@@ -33,4 +33,5 @@ resource "grafana_report" "test" {
   include_dashboard_link = false
   include_table_csv      = true
   formats                = ["csv", "image", "pdf"]
+  scale_factor           = 3
 }
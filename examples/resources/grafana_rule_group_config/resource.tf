@@ -0,0 +1,40 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Exported Rule Folder"
+}
+
+resource "grafana_rule_group_config" "my_exported_group" {
+  folder_uid = grafana_folder.rule_folder.uid
+  config     = <<-EOT
+    apiVersion: 1
+    groups:
+      - orgId: 1
+        name: My Exported Group
+        folder: Some Folder
+        interval: 1m
+        rules:
+          - title: My Exported Rule
+            condition: B
+            data:
+              - refId: A
+                relativeTimeRange:
+                  from: 600
+                  to: 0
+                datasourceUid: PD8C576611E62080A
+                model:
+                  refId: A
+                  hide: false
+              - refId: B
+                datasourceUid: __expr__
+                model:
+                  refId: B
+                  type: math
+                  expression: $A > 3
+            noDataState: NoData
+            execErrState: Error
+            for: 5m
+            annotations:
+              summary: It is high
+            labels:
+              team: backend
+  EOT
+}
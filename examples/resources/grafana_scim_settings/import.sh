@@ -0,0 +1,2 @@
+terraform import grafana_scim_settings.name "{{ anyString }}"
+terraform import grafana_scim_settings.name "{{ orgID }}:{{ anyString }}"
@@ -0,0 +1,5 @@
+resource "grafana_scim_settings" "settings" {
+  enable_user_sync             = true
+  enable_group_sync            = false
+  reject_non_provisioned_users = false
+}
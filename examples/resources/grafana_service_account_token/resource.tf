@@ -14,6 +14,17 @@ resource "grafana_service_account_token" "bar" {
   seconds_to_live    = 30
 }
 
+# Regenerated when it is about to expire, or when the keepers change
+resource "grafana_service_account_token" "rotating" {
+  name                          = "key_rotating"
+  service_account_id            = grafana_service_account.test.id
+  seconds_to_live               = 7776000 # 90 days
+  early_rotation_window_seconds = 604800  # 7 days
+  keepers = {
+    environment = "ci"
+  }
+}
+
 
 output "service_account_token_foo_key_only" {
   value     = grafana_service_account_token.foo.key
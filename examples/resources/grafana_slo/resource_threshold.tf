@@ -0,0 +1,39 @@
+resource "grafana_slo" "threshold" {
+  name        = "Terraform Testing - Threshold Query"
+  description = "Terraform Description - Threshold Query"
+  query {
+    threshold {
+      threshold_expression = "histogram_quantile(0.99, sum by (le, job) (rate(kubelet_http_requests_duration_seconds_bucket[$__rate_interval])))"
+      operator             = "<"
+      value                = 0.5
+      group_by_labels      = ["job"]
+    }
+    type = "threshold"
+  }
+  objectives {
+    value  = 0.99
+    window = "30d"
+  }
+  destination_datasource {
+    uid = "grafanacloud-prom"
+  }
+  label {
+    key   = "slo"
+    value = "terraform"
+  }
+  alerting {
+    fastburn {
+      annotation {
+        key   = "name"
+        value = "SLO Burn Rate Very High"
+      }
+    }
+
+    slowburn {
+      annotation {
+        key   = "name"
+        value = "SLO Burn Rate High"
+      }
+    }
+  }
+}
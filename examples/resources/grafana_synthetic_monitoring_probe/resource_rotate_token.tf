@@ -0,0 +1,14 @@
+resource "grafana_synthetic_monitoring_probe" "main" {
+  name      = "Mount Everest"
+  latitude  = 27.98606
+  longitude = 86.92262
+  region    = "APAC"
+  labels = {
+    type = "mountain"
+  }
+
+  # Change the rotation value to reset the auth token of the probe
+  keepers = {
+    rotation = "2024-06"
+  }
+}
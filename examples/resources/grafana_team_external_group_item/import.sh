@@ -0,0 +1,2 @@
+terraform import grafana_team_external_group_item.name "{{ teamID }}:{{ group }}"
+terraform import grafana_team_external_group_item.name "{{ orgID }}:{{ teamID }}:{{ group }}"
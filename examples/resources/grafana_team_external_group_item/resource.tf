@@ -0,0 +1,13 @@
+resource "grafana_team" "my_team" {
+  name = "My Team"
+}
+
+resource "grafana_team_external_group_item" "group_1" {
+  team_id = grafana_team.my_team.id
+  group   = "test-group-1"
+}
+
+resource "grafana_team_external_group_item" "group_2" {
+  team_id = grafana_team.my_team.id
+  group   = "test-group-2"
+}
@@ -1,5 +1,8 @@
 resource "grafana_dashboard" "metrics" {
-  config_json = file("grafana-dashboard.json")
+  config_json = jsonencode({
+    title = "Team Metrics"
+    uid   = "team-metrics"
+  })
 }
 
 resource "grafana_team" "team" {
@@ -10,5 +13,6 @@ resource "grafana_team_preferences" "team_preferences" {
   team_id            = grafana_team.team.id
   theme              = "dark"
   timezone           = "browser"
+  week_start         = "monday"
   home_dashboard_uid = grafana_dashboard.metrics.uid
 }
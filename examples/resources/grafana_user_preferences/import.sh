@@ -0,0 +1,2 @@
+terraform import grafana_user_preferences.name "{{ userID }}"
+terraform import grafana_user_preferences.name "{{ orgID }}:{{ userID }}"
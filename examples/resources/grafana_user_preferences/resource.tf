@@ -0,0 +1,13 @@
+resource "grafana_dashboard" "user_home" {
+  config_json = jsonencode({
+    title = "User Home"
+    uid   = "user-home"
+  })
+}
+
+resource "grafana_user_preferences" "current_user" {
+  home_dashboard_uid = grafana_dashboard.user_home.uid
+  theme              = "light"
+  timezone           = "browser"
+  week_start         = "sunday"
+}
@@ -3,7 +3,10 @@ package common
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,3 +38,154 @@ func JSONBytesEqual(b1, b2 []byte) bool {
 
 	return reflect.DeepEqual(o1, o2)
 }
+
+type jsonPathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parseJSONPath parses a path such as `panels[*].id`, `templating.list[0].current` or `$.fieldConfig['defaults'].links` into steps.
+// The path may start with the JSONPath root `$`. Keys are separated by `.` or quoted within brackets (eg. `['key.with.dots']`),
+// `[*]` matches all elements of an array and `[N]` matches the element at index N.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path must not be empty")
+	}
+	rest := path
+	if strings.HasPrefix(rest, "$") {
+		rest = rest[1:]
+		if rest == "" {
+			return nil, fmt.Errorf("invalid path %q: it must select a value within the root", path)
+		}
+	} else if rest[0] != '[' {
+		// Without the root, the path starts with a key
+		rest = "." + rest
+	}
+
+	steps := []jsonPathStep{}
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty key", path)
+			}
+			steps = append(steps, jsonPathStep{key: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			if len(rest) > 1 && (rest[1] == '\'' || rest[1] == '"') {
+				end := strings.IndexByte(rest[2:], rest[1])
+				if end < 0 || !strings.HasPrefix(rest[2+end+1:], "]") {
+					return nil, fmt.Errorf("invalid path %q: unterminated quoted key in %q", path, rest)
+				}
+				steps = append(steps, jsonPathStep{key: rest[2 : 2+end]})
+				rest = rest[2+end+2:]
+				continue
+			}
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unterminated index in %q", path, rest)
+			}
+			selector := rest[1:end]
+			rest = rest[end+1:]
+			if selector == "*" {
+				steps = append(steps, jsonPathStep{isIndex: true, wildcard: true})
+				continue
+			}
+			index, err := strconv.Atoi(selector)
+			if err != nil || selector[0] < '0' || selector[0] > '9' {
+				return nil, fmt.Errorf("invalid path %q: index must be `*`, a non-negative integer or a quoted key, got %q", path, selector)
+			}
+			steps = append(steps, jsonPathStep{isIndex: true, index: index})
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected `.` or `[` before %q", path, rest)
+		}
+	}
+	return steps, nil
+}
+
+// ValidateJSONPath is a ValidateFunc for attributes that contain paths accepted by RemoveJSONPaths.
+func ValidateJSONPath(i interface{}, k string) ([]string, []error) {
+	if _, err := parseJSONPath(i.(string)); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// RemoveJSONPaths removes the values matching the given paths from a decoded JSON value.
+// See parseJSONPath for the path syntax. Paths that do not match anything are ignored.
+func RemoveJSONPaths(value interface{}, paths []string) (interface{}, error) {
+	for _, path := range paths {
+		steps, err := parseJSONPath(path)
+		if err != nil {
+			return nil, err
+		}
+		value = removeJSONPath(value, steps)
+	}
+	return value, nil
+}
+
+func removeJSONPath(value interface{}, steps []jsonPathStep) interface{} {
+	step, last := steps[0], len(steps) == 1
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if step.isIndex {
+			return v
+		}
+		child, ok := v[step.key]
+		if !ok {
+			return v
+		}
+		if last {
+			delete(v, step.key)
+		} else {
+			v[step.key] = removeJSONPath(child, steps[1:])
+		}
+		return v
+	case []interface{}:
+		if !step.isIndex {
+			return v
+		}
+		if last {
+			if step.wildcard {
+				return []interface{}{}
+			}
+			if step.index < len(v) {
+				return append(v[:step.index:step.index], v[step.index+1:]...)
+			}
+			return v
+		}
+		for i := range v {
+			if step.wildcard || i == step.index {
+				v[i] = removeJSONPath(v[i], steps[1:])
+			}
+		}
+		return v
+	}
+	return value
+}
+
+// RemoveJSONNulls recursively removes object keys with null values from a decoded JSON value.
+func RemoveJSONNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if child == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = RemoveJSONNulls(child)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = RemoveJSONNulls(v[i])
+		}
+	}
+	return value
+}
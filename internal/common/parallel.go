@@ -0,0 +1,25 @@
+package common
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// MaxParallelRequests is the maximum number of API requests that a single resource or data source sends at the same time.
+// Terraform already runs multiple operations in parallel, so it is kept low to avoid overloading the APIs.
+const MaxParallelRequests = 5
+
+// ForEachParallel calls f for each of the items, with at most MaxParallelRequests calls running at the same time.
+// It returns the first error, after which the context given to the remaining calls is canceled.
+// To keep the order of the items, f should store its result at the index it is given.
+func ForEachParallel[T any](ctx context.Context, items []T, f func(ctx context.Context, i int, item T) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(MaxParallelRequests)
+	for i, item := range items {
+		g.Go(func() error {
+			return f(ctx, i, item)
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachParallel(t *testing.T) {
+	t.Run("limits the number of concurrent calls", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+		items := make([]int, 4*MaxParallelRequests)
+		err := ForEachParallel(context.Background(), items, func(ctx context.Context, i int, item int) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(MaxParallelRequests), maxRunning.Load())
+	})
+
+	t.Run("returns the first error and cancels the remaining calls", func(t *testing.T) {
+		expectedErr := errors.New("failed")
+		var canceled atomic.Int32
+		items := make([]int, 2*MaxParallelRequests)
+		err := ForEachParallel(context.Background(), items, func(ctx context.Context, i int, item int) error {
+			if i == 0 {
+				return expectedErr
+			}
+			select {
+			case <-ctx.Done():
+				canceled.Add(1)
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		})
+		require.ErrorIs(t, err, expectedErr)
+		require.Equal(t, int32(len(items)-1), canceled.Load())
+	})
+
+	t.Run("keeps the results at the index of their item", func(t *testing.T) {
+		items := make([]int, 100)
+		for i := range items {
+			items[i] = i * 10
+		}
+		results := make([]int, len(items))
+		err := ForEachParallel(context.Background(), items, func(ctx context.Context, i int, item int) error {
+			// Finish the calls in a different order than they were started
+			time.Sleep(time.Duration(len(items)-i) * 100 * time.Microsecond)
+			results[i] = item + 1
+			return nil
+		})
+		require.NoError(t, err)
+		for i, result := range results {
+			require.Equal(t, i*10+1, result)
+		}
+	})
+}
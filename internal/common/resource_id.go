@@ -19,6 +19,8 @@ type ResourceIDField struct {
 	Name     string
 	Type     ResourceIDFieldType
 	Optional bool
+	// Trailing fields are the last field of the ID and may contain the separator (eg. SAML group URNs)
+	Trailing bool
 }
 
 func StringIDField(name string) ResourceIDField {
@@ -28,6 +30,16 @@ func StringIDField(name string) ResourceIDField {
 	}
 }
 
+// TrailingStringIDField is a string field that keeps everything after the previous fields, separators included.
+// It must be the last field of the ID.
+func TrailingStringIDField(name string) ResourceIDField {
+	return ResourceIDField{
+		Name:     name,
+		Type:     ResourceIDFieldTypeString,
+		Trailing: true,
+	}
+}
+
 func IntIDField(name string) ResourceIDField {
 	return ResourceIDField{
 		Name: name,
@@ -70,6 +82,11 @@ func (id *ResourceID) RequiredFields() []ResourceIDField {
 }
 
 func NewResourceID(expectedFields ...ResourceIDField) *ResourceID {
+	for i, f := range expectedFields {
+		if f.Trailing && i != len(expectedFields)-1 {
+			panic(fmt.Sprintf("trailing field %q must be the last field", f.Name)) // This is a coding error, so panic is appropriate
+		}
+	}
 	return &ResourceID{
 		expectedFields: expectedFields,
 	}
@@ -142,6 +159,9 @@ func (id *ResourceID) Split(resourceID string) ([]any, error) {
 // The parts will be cast to the expected types
 func split(resourceID string, expectedFields []ResourceIDField) ([]any, error) {
 	parts := strings.Split(resourceID, ResourceIDSeparator)
+	if len(expectedFields) > 0 && expectedFields[len(expectedFields)-1].Trailing {
+		parts = strings.SplitN(resourceID, ResourceIDSeparator, len(expectedFields))
+	}
 	if len(parts) == len(expectedFields) {
 		partsAsAny := make([]any, len(parts))
 		for i, part := range parts {
@@ -167,3 +187,11 @@ func split(resourceID string, expectedFields []ResourceIDField) ([]any, error) {
 	}
 	return nil, fmt.Errorf("id %q does not match expected format. Should be in the format: %s", resourceID, strings.Join(expectedFieldNames, ResourceIDSeparator))
 }
+
+// ImportByNamePrefix is the prefix of the import IDs that reference a resource by its name instead of its ID (eg. `name:Payments Team`).
+const ImportByNamePrefix = "name:"
+
+// ImportName returns the name referenced by an import ID like `name:<name>`, or false if the import ID isn't in this format.
+func ImportName(importID string) (string, bool) {
+	return strings.CutPrefix(importID, ImportByNamePrefix)
+}
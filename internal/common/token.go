@@ -0,0 +1,26 @@
+package common
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const rotatedTokenNameSuffixFormat = "20060102150405"
+
+// The suffix of the names of the regenerated tokens, which is their creation time
+var rotatedTokenNameSuffixRegexp = regexp.MustCompile(`^-\d{14}$`)
+
+// RotatedTokenName returns the name of a regenerated token: the configured name followed by its creation time.
+// The tokens are created before the old ones are deleted, so the suffix keeps the names unique.
+func RotatedTokenName(name string) string {
+	return name + "-" + time.Now().UTC().Format(rotatedTokenNameSuffixFormat)
+}
+
+// TrimRotatedTokenName returns the name to store in the state: the regenerated tokens keep the configured name, without their suffix.
+func TrimRotatedTokenName(configuredName, tokenName string) string {
+	if suffix, ok := strings.CutPrefix(tokenName, configuredName); ok && configuredName != "" && rotatedTokenNameSuffixRegexp.MatchString(suffix) {
+		return configuredName
+	}
+	return tokenName
+}
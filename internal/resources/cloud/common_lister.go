@@ -27,10 +27,17 @@ func NewListerData(orgSlug string) *ListerData {
 func (d *ListerData) Stacks(ctx context.Context, client *gcom.APIClient) ([]gcom.FormattedApiInstance, error) {
 	var err error
 	d.stacksInit.Do(func() {
-		stacksReq := client.InstancesAPI.GetInstances(ctx)
-		var stacksResp *gcom.GetInstances200Response
-		stacksResp, _, err = stacksReq.Execute()
-		d.stacks = stacksResp.Items
+		for page := int32(1); ; page++ {
+			var stacksResp *gcom.GetInstances200Response
+			stacksResp, _, err = client.InstancesAPI.GetInstances(ctx).Page(page).Execute()
+			if err != nil {
+				return
+			}
+			d.stacks = append(d.stacks, stacksResp.Items...)
+			if float32(page) >= stacksResp.Pages {
+				return
+			}
+		}
 	})
 	return d.stacks, err
 }
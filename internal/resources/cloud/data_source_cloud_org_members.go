@@ -0,0 +1,135 @@
+package cloud
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dataSourceOrgMembersName = "grafana_cloud_org_members"
+
+func datasourceOrgMembers() *common.DataSource {
+	return common.NewDataSource(
+		common.CategoryCloud,
+		dataSourceOrgMembersName,
+		&OrgMembersDataSource{},
+	)
+}
+
+type OrgMembersDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *OrgMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceOrgMembersName
+}
+
+func (r *OrgMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetches the members of a Grafana Cloud organization, with their role. Useful to review the access to the organization.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/authentication-and-permissions/cloud-roles/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-org-members)
+
+Required access policy scopes:
+
+* orgs:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this datasource. This is an internal identifier used by the provider to track this datasource.",
+			},
+			"org": schema.StringAttribute{
+				Required:    true,
+				Description: "The slug or ID of the organization.",
+			},
+			"role_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only the members with the specified role will be returned.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Admin", "Editor", "Viewer", "None"),
+				},
+			},
+			"members": schema.ListAttribute{
+				Computed:    true,
+				Description: "The members of the organization, sorted by username.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"user_id":                types.StringType,
+						"username":               types.StringType,
+						"name":                   types.StringType,
+						"email":                  types.StringType,
+						"role":                   types.StringType,
+						"receive_billing_emails": types.BoolType,
+						"created_at":             types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type OrgMembersDataSourceMemberModel struct {
+	UserID               types.String `tfsdk:"user_id"`
+	Username             types.String `tfsdk:"username"`
+	Name                 types.String `tfsdk:"name"`
+	Email                types.String `tfsdk:"email"`
+	Role                 types.String `tfsdk:"role"`
+	ReceiveBillingEmails types.Bool   `tfsdk:"receive_billing_emails"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+}
+
+type OrgMembersDataSourceModel struct {
+	ID         types.String                      `tfsdk:"id"`
+	Org        types.String                      `tfsdk:"org"`
+	RoleFilter types.String                      `tfsdk:"role_filter"`
+	Members    []OrgMembersDataSourceMemberModel `tfsdk:"members"`
+}
+
+func (r *OrgMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data OrgMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, _, err := r.client.OrgsAPI.GetOrgMembers(ctx, data.Org.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get org members", err.Error())}
+		return
+	}
+
+	data.Members = []OrgMembersDataSourceMemberModel{}
+	for _, member := range apiResp.Items {
+		if data.RoleFilter.ValueString() != "" && data.RoleFilter.ValueString() != member.Role {
+			continue
+		}
+		data.Members = append(data.Members, OrgMembersDataSourceMemberModel{
+			UserID:               types.StringValue(strconv.FormatInt(int64(member.UserId), 10)),
+			Username:             types.StringValue(member.UserUsername),
+			Name:                 types.StringValue(member.UserName),
+			Email:                types.StringValue(member.UserEmail),
+			Role:                 types.StringValue(member.Role),
+			ReceiveBillingEmails: types.BoolValue(member.Billing == 1),
+			CreatedAt:            types.StringValue(member.CreatedAt),
+		})
+	}
+	sort.Slice(data.Members, func(i, j int) bool {
+		return data.Members[i].Username.ValueString() < data.Members[j].Username.ValueString()
+	})
+	data.ID = types.StringValue(data.Org.ValueString() + "-" + data.RoleFilter.ValueString()) // Unique ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
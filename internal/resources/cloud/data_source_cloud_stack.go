@@ -28,6 +28,7 @@ available at “https://<stack_slug>.grafana.net".`,
 			},
 			"wait_for_readiness":         nil,
 			"wait_for_readiness_timeout": nil,
+			"wait_for_readiness_checks":  nil,
 		}),
 	}
 	return common.NewLegacySDKDataSource(common.CategoryCloud, "grafana_cloud_stack", schema)
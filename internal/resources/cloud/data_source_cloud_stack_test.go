@@ -54,3 +54,47 @@ data "grafana_cloud_stack" "test" {
 }
 `, resourceName, resourceName)
 }
+
+func TestAccDataSourceStacks_Basic(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	prefix := "tfdatatest"
+
+	resourceName := GetRandomStackName(prefix)
+	var stack gcom.FormattedApiInstance
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccDeleteExistingStacks(t, prefix)
+		},
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccStackCheckDestroy(&stack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStacksConfig(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_cloud_stacks.test", "stacks.*", map[string]string{
+						"name":                             resourceName,
+						"slug":                             resourceName,
+						"prometheus_remote_write_endpoint": "https://prometheus-prod-01-eu-west-0.grafana.net/api/prom/push",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStacksConfig(resourceName string) string {
+	return fmt.Sprintf(`
+resource "grafana_cloud_stack" "test" {
+  name = "%s"
+  slug = "%s"
+  region_slug = "eu"
+}
+data "grafana_cloud_stacks" "test" {
+  org_slug_filter = grafana_cloud_stack.test.org_slug
+  region_filter   = grafana_cloud_stack.test.region_slug
+  depends_on      = [grafana_cloud_stack.test]
+}
+`, resourceName, resourceName)
+}
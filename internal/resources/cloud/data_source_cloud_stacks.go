@@ -0,0 +1,167 @@
+package cloud
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dataSourceStacksName = "grafana_cloud_stacks"
+
+func datasourceStacks() *common.DataSource {
+	return common.NewDataSource(
+		common.CategoryCloud,
+		dataSourceStacksName,
+		&StacksDataSource{},
+	)
+}
+
+type StacksDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *StacksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceStacksName
+}
+
+func (r *StacksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetches the Grafana Cloud stacks that the provider can access, with their endpoints.
+Use the ` + "`grafana_cloud_stack`" + ` data source to get all the attributes of a stack.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/cloud-stacks/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-stacks)
+
+Required access policy scopes:
+
+* stacks:read`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this datasource. This is an internal identifier used by the provider to track this datasource.",
+			},
+			"org_slug_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only the stacks of the specified organization will be returned.",
+			},
+			"region_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only the stacks in the specified region will be returned.",
+			},
+			"stacks": schema.ListAttribute{
+				Computed:    true,
+				Description: "The stacks, sorted by slug.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":                               types.StringType,
+						"slug":                             types.StringType,
+						"name":                             types.StringType,
+						"url":                              types.StringType,
+						"region_slug":                      types.StringType,
+						"status":                           types.StringType,
+						"org_slug":                         types.StringType,
+						"prometheus_user_id":               types.Int64Type,
+						"prometheus_url":                   types.StringType,
+						"prometheus_remote_write_endpoint": types.StringType,
+						"logs_user_id":                     types.Int64Type,
+						"logs_url":                         types.StringType,
+						"traces_user_id":                   types.Int64Type,
+						"traces_url":                       types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type StacksDataSourceStackModel struct {
+	ID                            types.String `tfsdk:"id"`
+	Slug                          types.String `tfsdk:"slug"`
+	Name                          types.String `tfsdk:"name"`
+	URL                           types.String `tfsdk:"url"`
+	RegionSlug                    types.String `tfsdk:"region_slug"`
+	Status                        types.String `tfsdk:"status"`
+	OrgSlug                       types.String `tfsdk:"org_slug"`
+	PrometheusUserID              types.Int64  `tfsdk:"prometheus_user_id"`
+	PrometheusURL                 types.String `tfsdk:"prometheus_url"`
+	PrometheusRemoteWriteEndpoint types.String `tfsdk:"prometheus_remote_write_endpoint"`
+	LogsUserID                    types.Int64  `tfsdk:"logs_user_id"`
+	LogsURL                       types.String `tfsdk:"logs_url"`
+	TracesUserID                  types.Int64  `tfsdk:"traces_user_id"`
+	TracesURL                     types.String `tfsdk:"traces_url"`
+}
+
+type StacksDataSourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	OrgSlugFilter types.String                 `tfsdk:"org_slug_filter"`
+	RegionFilter  types.String                 `tfsdk:"region_filter"`
+	Stacks        []StacksDataSourceStackModel `tfsdk:"stacks"`
+}
+
+func (r *StacksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data StacksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Stacks = []StacksDataSourceStackModel{}
+	for page := int32(1); ; page++ {
+		stacksReq := r.client.InstancesAPI.GetInstances(ctx).Page(page)
+		if data.OrgSlugFilter.ValueString() != "" {
+			stacksReq = stacksReq.OrgSlug(data.OrgSlugFilter.ValueString())
+		}
+		apiResp, _, err := stacksReq.Execute()
+		if err != nil {
+			resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get stacks", err.Error())}
+			return
+		}
+
+		for _, stack := range apiResp.Items {
+			if data.RegionFilter.ValueString() != "" && data.RegionFilter.ValueString() != stack.RegionSlug {
+				continue
+			}
+			rweURL, err := appendPath(stack.HmInstancePromUrl, "/api/prom/push")
+			if err != nil {
+				resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to build the remote write endpoint", err.Error())}
+				return
+			}
+			data.Stacks = append(data.Stacks, StacksDataSourceStackModel{
+				ID:                            types.StringValue(strconv.FormatInt(int64(stack.Id), 10)),
+				Slug:                          types.StringValue(stack.Slug),
+				Name:                          types.StringValue(stack.Name),
+				URL:                           types.StringValue(stack.Url),
+				RegionSlug:                    types.StringValue(stack.RegionSlug),
+				Status:                        types.StringValue(stack.Status),
+				OrgSlug:                       types.StringValue(stack.OrgSlug),
+				PrometheusUserID:              types.Int64Value(int64(stack.HmInstancePromId)),
+				PrometheusURL:                 types.StringValue(stack.HmInstancePromUrl),
+				PrometheusRemoteWriteEndpoint: types.StringValue(rweURL),
+				LogsUserID:                    types.Int64Value(int64(stack.HlInstanceId)),
+				LogsURL:                       types.StringValue(stack.HlInstanceUrl),
+				TracesUserID:                  types.Int64Value(int64(stack.HtInstanceId)),
+				TracesURL:                     types.StringValue(stack.HtInstanceUrl),
+			})
+		}
+
+		if float32(page) >= apiResp.Pages {
+			break
+		}
+	}
+	sort.Slice(data.Stacks, func(i, j int) bool {
+		return data.Stacks[i].Slug.ValueString() < data.Stacks[j].Slug.ValueString()
+	})
+	data.ID = types.StringValue(data.OrgSlugFilter.ValueString() + "-" + data.RegionFilter.ValueString()) // Unique ID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
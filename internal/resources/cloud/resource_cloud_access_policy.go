@@ -110,6 +110,25 @@ Required access policy scopes:
 				Required: true,
 				Elem:     cloudAccessPolicyRealmSchema,
 			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Conditions restricting where the tokens of the access policy can be used.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_subnets": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "The subnets, in CIDR notation (eg. `10.0.0.0/8`), from which the tokens of the access policy can be used.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+					},
+				},
+			},
 
 			// Computed
 			"policy_id": {
@@ -181,6 +200,7 @@ func createCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, client
 			DisplayName: &displayName,
 			Scopes:      common.ListToStringSlice(d.Get("scopes").(*schema.Set).List()),
 			Realms:      expandCloudAccessPolicyRealm(d.Get("realm").(*schema.Set).List()),
+			Conditions:  expandCloudAccessPolicyConditions(d.Get("conditions").([]interface{})),
 		})
 	result, _, err := req.Execute()
 	if err != nil {
@@ -209,6 +229,7 @@ func updateCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, client
 			DisplayName: &displayName,
 			Scopes:      common.ListToStringSlice(d.Get("scopes").(*schema.Set).List()),
 			Realms:      expandCloudAccessPolicyRealm(d.Get("realm").(*schema.Set).List()),
+			Conditions:  expandCloudAccessPolicyConditions(d.Get("conditions").([]interface{})),
 		})
 	if _, _, err = req.Execute(); err != nil {
 		return apiError(err)
@@ -235,6 +256,7 @@ func readCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, client *
 	d.Set("display_name", result.DisplayName)
 	d.Set("scopes", result.Scopes)
 	d.Set("realm", flattenCloudAccessPolicyRealm(result.Realms))
+	d.Set("conditions", flattenCloudAccessPolicyConditions(result.Conditions))
 	d.Set("created_at", result.CreatedAt.Format(time.RFC3339))
 	if updated := result.UpdatedAt; updated != nil {
 		d.Set("updated_at", updated.Format(time.RFC3339))
@@ -304,3 +326,32 @@ func expandCloudAccessPolicyRealm(realm []interface{}) []gcom.PostAccessPolicies
 	}
 	return result
 }
+
+func flattenCloudAccessPolicyConditions(conditions *gcom.AuthAccessPolicyConditions) []interface{} {
+	if conditions == nil || len(conditions.AllowedSubnets) == 0 {
+		return nil
+	}
+
+	allowedSubnets := []string{}
+	for _, subnet := range conditions.AllowedSubnets {
+		if subnet.String != nil {
+			allowedSubnets = append(allowedSubnets, *subnet.String)
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_subnets": allowedSubnets,
+		},
+	}
+}
+
+// expandCloudAccessPolicyConditions always returns conditions, so that they are removed when they are no longer configured.
+func expandCloudAccessPolicyConditions(conditions []interface{}) *gcom.PostAccessPoliciesRequestConditions {
+	allowedSubnets := []string{}
+	if len(conditions) > 0 && conditions[0] != nil {
+		allowedSubnets = common.SetToStringSlice(conditions[0].(map[string]interface{})["allowed_subnets"].(*schema.Set))
+	}
+	return &gcom.PostAccessPoliciesRequestConditions{
+		AllowedSubnets: allowedSubnets,
+	}
+}
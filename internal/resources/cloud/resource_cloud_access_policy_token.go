@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/grafana/grafana-com-public-clients/go/gcom"
@@ -25,6 +26,9 @@ func resourceAccessPolicyToken() *common.Resource {
 * [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/authentication-and-permissions/access-policies/)
 * [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#create-a-token)
 
+The token can be rotated by routine applies: with ` + "`rotate_after`" + `, it is regenerated by the first apply after it has reached that age.
+The new token is created before the old one is deleted. Since token names are unique per access policy, the names of the regenerated tokens get a suffix with their creation time (eg. ` + "`my-token-20240101120000`" + `).
+
 Required access policy scopes:
 
 * accesspolicies:read
@@ -36,66 +40,13 @@ Required access policy scopes:
 		UpdateContext: withClient[schema.UpdateContextFunc](updateCloudAccessPolicyToken),
 		DeleteContext: withClient[schema.DeleteContextFunc](deleteCloudAccessPolicyToken),
 		ReadContext:   withClient[schema.ReadContextFunc](readCloudAccessPolicyToken),
+		CustomizeDiff: accessPolicyTokenCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"access_policy_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of the access policy for which to create a token.",
-			},
-			"region": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Region of the access policy. Should be set to the same region as the access policy. Use the region list API to get the list of available regions: https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-regions.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the access policy token.",
-			},
-			"display_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Display name of the access policy token. Defaults to the name.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if new == "" && old == d.Get("name").(string) {
-						return true
-					}
-					return false
-				},
-			},
-			"expires_at": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Expiration date of the access policy token. Does not expire by default.",
-				ValidateFunc: validation.IsRFC3339Time,
-			},
-
-			// Computed
-			"token": {
-				Type:      schema.TypeString,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"created_at": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Creation date of the access policy token.",
-			},
-			"updated_at": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Last update date of the access policy token.",
-			},
-		},
+		Schema: accessPolicyTokenSchema("access_policy_id", "ID of the access policy for which to create a token."),
 	}
 
 	return common.NewLegacySDKResource(
@@ -106,12 +57,158 @@ Required access policy scopes:
 	)
 }
 
+// accessPolicyTokenSchema returns the schema of the token resources, whose policy is referenced by the given attribute.
+func accessPolicyTokenSchema(policyIDAttribute, policyIDDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		policyIDAttribute: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: policyIDDescription,
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Region of the access policy. Should be set to the same region as the access policy. Use the region list API to get the list of available regions: https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-regions.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the access policy token.",
+		},
+		"display_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Display name of the access policy token. Defaults to the name.",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				if new == "" && old == d.Get("name").(string) {
+					return true
+				}
+				return false
+			},
+		},
+		"expires_at": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true, // Set from expire_after
+			ForceNew:      true,
+			ConflictsWith: []string{"expire_after"},
+			Description:   "Expiration date of the access policy token. Does not expire by default.",
+			ValidateFunc:  validation.IsRFC3339Time,
+		},
+		"expire_after": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ConflictsWith:    []string{"expires_at"},
+			Description:      "Duration after its creation at which the token expires (eg. `720h`). Unlike `expires_at`, each regenerated token gets a new expiration date. Changing it regenerates the token.",
+			ValidateDiagFunc: common.ValidateDuration,
+		},
+		"rotate_after": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Duration after its creation at which the token is regenerated by the next apply (eg. `600h`). Must be shorter than `expire_after` so that the new token is created before the old one expires. Changing it regenerates the token if it is older than the new duration.",
+			ValidateDiagFunc: common.ValidateDuration,
+		},
+
+		// Computed
+		"token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation date of the access policy token.",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last update date of the access policy token.",
+		},
+		"ready_for_rotation": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the token is older than `rotate_after`, and will be regenerated by the next apply.",
+		},
+	}
+}
+
+// accessPolicyTokenCustomizeDiff checks the rotation settings and plans the regeneration of the tokens that are ready for rotation.
+// The tokens are regenerated by the update, so that the new token is created before the old one is deleted.
+func accessPolicyTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	expireAfter, rotateAfter := d.Get("expire_after").(string), d.Get("rotate_after").(string)
+	if expireAfter != "" && rotateAfter != "" {
+		expireAfterDuration, _ := time.ParseDuration(expireAfter)
+		rotateAfterDuration, _ := time.ParseDuration(rotateAfter)
+		if rotateAfterDuration >= expireAfterDuration {
+			return fmt.Errorf("rotate_after (%s) must be shorter than expire_after (%s)", rotateAfter, expireAfter)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Removing expires_at from the config creates a token that doesn't expire
+	if d.GetRawConfig().GetAttr("expires_at").IsNull() && expireAfter == "" {
+		if old, _ := d.GetChange("expires_at"); old.(string) != "" {
+			if err := d.SetNew("expires_at", ""); err != nil {
+				return err
+			}
+			return d.ForceNew("expires_at")
+		}
+	}
+
+	readyForRotation := d.Get("ready_for_rotation").(bool)
+	// The readiness is computed when reading the token, it must be computed again if rotate_after changes
+	if d.HasChange("rotate_after") {
+		readyForRotation = false
+		if rotateAfter != "" {
+			rotateAfterDuration, _ := time.ParseDuration(rotateAfter)
+			createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+			readyForRotation = err == nil && !time.Now().Before(createdAt.Add(rotateAfterDuration))
+		}
+		if err := d.SetNew("ready_for_rotation", readyForRotation); err != nil {
+			return err
+		}
+	}
+	if !readyForRotation {
+		return nil
+	}
+
+	for _, key := range []string{"token", "created_at", "updated_at"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	if expireAfter != "" {
+		return d.SetNewComputed("expires_at")
+	}
+	return nil
+}
+
 func createCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return createToken(ctx, d, client, "access_policy_id")
+}
+
+func createToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient, policyIDAttribute string) diag.Diagnostics {
+	if err := postToken(ctx, d, client, policyIDAttribute, d.Get("name").(string)); err != nil {
+		return err
+	}
+	return readToken(ctx, d, client, policyIDAttribute)
+}
+
+// postToken creates a token with the given name, and sets it as the token of the resource.
+func postToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient, policyIDAttribute, name string) diag.Diagnostics {
 	region := d.Get("region").(string)
 
 	tokenInput := gcom.PostTokensRequest{
-		AccessPolicyId: d.Get("access_policy_id").(string),
-		Name:           d.Get("name").(string),
+		AccessPolicyId: d.Get(policyIDAttribute).(string),
+		Name:           name,
 		DisplayName:    common.Ref(d.Get("display_name").(string)),
 	}
 
@@ -122,6 +219,13 @@ func createCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, c
 		}
 		tokenInput.ExpiresAt = &expiresAt
 	}
+	if v, ok := d.GetOk("expire_after"); ok {
+		expireAfter, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tokenInput.ExpiresAt = common.Ref(time.Now().Add(expireAfter).UTC().Truncate(time.Second))
+	}
 
 	req := client.TokensAPI.PostTokens(ctx).Region(region).XRequestId(ClientRequestID()).PostTokensRequest(tokenInput)
 	result, _, err := req.Execute()
@@ -132,16 +236,24 @@ func createCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, c
 	d.SetId(resourceAccessPolicyTokenID.Make(region, result.Id))
 	d.Set("token", result.Token)
 
-	return readCloudAccessPolicyToken(ctx, d, client)
+	return nil
 }
 
 func updateCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return updateToken(ctx, d, client, "access_policy_id")
+}
+
+func updateToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient, policyIDAttribute string) diag.Diagnostics {
 	split, err := resourceAccessPolicyTokenID.Split(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	region, id := split[0], split[1]
 
+	if d.Get("ready_for_rotation").(bool) {
+		return rotateToken(ctx, d, client, policyIDAttribute)
+	}
+
 	displayName := d.Get("display_name").(string)
 	if displayName == "" {
 		displayName = d.Get("name").(string)
@@ -154,10 +266,34 @@ func updateCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, c
 		return apiError(err)
 	}
 
-	return readCloudAccessPolicyToken(ctx, d, client)
+	return readToken(ctx, d, client, policyIDAttribute)
+}
+
+// rotateToken creates a new token, then deletes the old one.
+func rotateToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient, policyIDAttribute string) diag.Diagnostics {
+	oldID := d.Id()
+	name := common.RotatedTokenName(d.Get("name").(string))
+	if err := postToken(ctx, d, client, policyIDAttribute, name); err != nil {
+		return err
+	}
+
+	split, err := resourceAccessPolicyTokenID.Split(oldID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region, id := split[0], split[1]
+	if _, _, err := client.TokensAPI.DeleteToken(ctx, id.(string)).Region(region.(string)).XRequestId(ClientRequestID()).Execute(); err != nil && !common.IsNotFoundError(err) {
+		return append(readToken(ctx, d, client, policyIDAttribute), diag.Errorf("the token was regenerated, but the old token %s could not be deleted: %v", oldID, err)...)
+	}
+
+	return readToken(ctx, d, client, policyIDAttribute)
 }
 
 func readCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return readToken(ctx, d, client, "access_policy_id")
+}
+
+func readToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient, policyIDAttribute string) diag.Diagnostics {
 	split, err := resourceAccessPolicyTokenID.Split(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -169,9 +305,9 @@ func readCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, cli
 		return err
 	}
 
-	d.Set("access_policy_id", result.AccessPolicyId)
+	d.Set(policyIDAttribute, result.AccessPolicyId)
 	d.Set("region", region)
-	d.Set("name", result.Name)
+	d.Set("name", common.TrimRotatedTokenName(d.Get("name").(string), result.Name))
 	d.Set("display_name", result.DisplayName)
 	d.Set("created_at", result.CreatedAt.Format(time.RFC3339))
 	if result.ExpiresAt != nil {
@@ -180,6 +316,16 @@ func readCloudAccessPolicyToken(ctx context.Context, d *schema.ResourceData, cli
 	if result.UpdatedAt != nil {
 		d.Set("updated_at", result.UpdatedAt.Format(time.RFC3339))
 	}
+
+	readyForRotation := false
+	if v := d.Get("rotate_after").(string); v != "" {
+		rotateAfter, err := time.ParseDuration(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		readyForRotation = !time.Now().Before(result.GetCreatedAt().Add(rotateAfter))
+	}
+	d.Set("ready_for_rotation", readyForRotation)
 	d.SetId(resourceAccessPolicyTokenID.Make(region, result.Id))
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -140,6 +141,52 @@ func TestResourceAccessPolicyToken_Basic(t *testing.T) {
 	})
 }
 
+func TestResourceAccessPolicy_Conditions(t *testing.T) {
+	t.Parallel()
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var policy gcom.AuthAccessPolicy
+
+	randomName := fmt.Sprintf("conditions-%s", acctest.RandStringFromCharSet(6, acctest.CharSetAlpha))
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCloudAccessPolicyCheckDestroy("us", &policy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudAccessPolicyConfigConditions(randomName, `"10.0.0.0/8", "192.168.1.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "conditions.0.allowed_subnets.#", "2"),
+					resource.TestCheckTypeSetElemAttr("grafana_cloud_access_policy.test", "conditions.0.allowed_subnets.*", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemAttr("grafana_cloud_access_policy.test", "conditions.0.allowed_subnets.*", "192.168.1.0/24"),
+				),
+			},
+			{
+				ResourceName:      "grafana_cloud_access_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudAccessPolicyConfigConditions(randomName, `"172.16.0.0/12"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "conditions.0.allowed_subnets.#", "1"),
+					resource.TestCheckTypeSetElemAttr("grafana_cloud_access_policy.test", "conditions.0.allowed_subnets.*", "172.16.0.0/12"),
+				),
+			},
+			// Remove the conditions
+			{
+				Config: testAccCloudAccessPolicyConfigConditions(randomName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "conditions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestResourceAccessPolicyToken_NoExpiration(t *testing.T) {
 	t.Parallel()
 	testutils.CheckCloudAPITestsEnabled(t)
@@ -169,6 +216,63 @@ func TestResourceAccessPolicyToken_NoExpiration(t *testing.T) {
 	})
 }
 
+func TestResourceAccessPolicyToken_Rotation(t *testing.T) {
+	t.Parallel()
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var policy gcom.AuthAccessPolicy
+	var policyToken gcom.AuthToken
+	var initialToken gcom.AuthToken
+
+	randomName := fmt.Sprintf("rotation-%s", acctest.RandStringFromCharSet(6, acctest.CharSetAlpha))
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCloudAccessPolicyCheckDestroy("us", &policy),
+			testAccCloudAccessPolicyTokenCheckDestroy("us", &policyToken),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudAccessPolicyTokenConfigRotation(randomName, "1h", "2h"),
+				ExpectError: regexp.MustCompile(`rotate_after \(2h\) must be shorter than expire_after \(1h\)`),
+			},
+			{
+				Config: testAccCloudAccessPolicyTokenConfigRotation(randomName, "1h", "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					testAccCloudAccessPolicyTokenCheckExists("grafana_cloud_access_policy_token.test", &policyToken),
+					resource.TestCheckResourceAttrSet("grafana_cloud_access_policy_token.test", "expires_at"),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy_token.test", "ready_for_rotation", "false"),
+					func(s *terraform.State) error {
+						initialToken = policyToken
+						return nil
+					},
+				),
+			},
+			// The token is regenerated once it is older than rotate_after, the old token is deleted after the new one is created
+			{
+				PreConfig: func() { time.Sleep(30 * time.Second) },
+				Config:    testAccCloudAccessPolicyTokenConfigRotation(randomName, "1h", "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyTokenCheckExists("grafana_cloud_access_policy_token.test", &policyToken),
+					testAccCloudAccessPolicyTokenCheckDestroy("us", &initialToken),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy_token.test", "name", "token-"+randomName),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy_token.test", "ready_for_rotation", "false"),
+					func(s *terraform.State) error {
+						if *policyToken.Id == *initialToken.Id {
+							return fmt.Errorf("expected the token to be regenerated")
+						}
+						if !strings.HasPrefix(policyToken.Name, "token-"+randomName+"-") {
+							return fmt.Errorf("expected the name of the regenerated token to have a suffix, got %s", policyToken.Name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudAccessPolicyCheckExists(rn string, a *gcom.AuthAccessPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[rn]
@@ -306,3 +410,60 @@ func testAccCloudAccessPolicyTokenConfigBasic(name, displayName, region string,
 	}
 	`, name, displayName, strings.Join(scopes, `","`), os.Getenv("GRAFANA_CLOUD_ORG"), expiresAt, region)
 }
+
+func testAccCloudAccessPolicyConfigConditions(name, allowedSubnets string) string {
+	conditions := ""
+	if allowedSubnets != "" {
+		conditions = fmt.Sprintf(`
+		conditions {
+			allowed_subnets = [%s]
+		}`, allowedSubnets)
+	}
+
+	return fmt.Sprintf(`
+	data "grafana_cloud_organization" "current" {
+		slug = "%[2]s"
+	}
+
+	resource "grafana_cloud_access_policy" "test" {
+		region = "us"
+		name   = "%[1]s"
+
+		scopes = ["metrics:read"]
+
+		realm {
+			type       = "org"
+			identifier = data.grafana_cloud_organization.current.id
+		}
+		%[3]s
+	}
+	`, name, os.Getenv("GRAFANA_CLOUD_ORG"), conditions)
+}
+
+func testAccCloudAccessPolicyTokenConfigRotation(name, expireAfter, rotateAfter string) string {
+	return fmt.Sprintf(`
+	data "grafana_cloud_organization" "current" {
+		slug = "%[2]s"
+	}
+
+	resource "grafana_cloud_access_policy" "test" {
+		region = "us"
+		name   = "%[1]s"
+
+		scopes = ["metrics:write"]
+
+		realm {
+			type       = "org"
+			identifier = data.grafana_cloud_organization.current.id
+		}
+	}
+
+	resource "grafana_cloud_access_policy_token" "test" {
+		region           = "us"
+		access_policy_id = grafana_cloud_access_policy.test.policy_id
+		name             = "token-%[1]s"
+		expire_after     = "%[3]s"
+		rotate_after     = "%[4]s"
+	}
+	`, name, os.Getenv("GRAFANA_CLOUD_ORG"), expireAfter, rotateAfter)
+}
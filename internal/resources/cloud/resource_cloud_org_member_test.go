@@ -46,6 +46,17 @@ func TestAccResourceOrgMember(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_cloud_org_member.test", "receive_billing_emails", "false"),
 				),
 			},
+			{
+				Config: testAccCloudOrgMember(org, testOrgMemberUser, "Editor", false) + testAccDataSourceOrgMembers(org, "Editor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_cloud_org_members.test", "role_filter", "Editor"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_cloud_org_members.test", "members.*", map[string]string{
+						"username":               testOrgMemberUser,
+						"role":                   "Editor",
+						"receive_billing_emails": "false",
+					}),
+				),
+			},
 			{
 				ResourceName:      "grafana_cloud_org_member.test",
 				ImportState:       true,
@@ -116,3 +127,13 @@ resource "grafana_cloud_org_member" "test" {
 }
 `, org, user, role, receiveBillingEmails)
 }
+
+func testAccDataSourceOrgMembers(org, roleFilter string) string {
+	return fmt.Sprintf(`
+data "grafana_cloud_org_members" "test" {
+	org         = "%s"
+	role_filter = "%s"
+	depends_on  = [grafana_cloud_org_member.test]
+}
+`, org, roleFilter)
+}
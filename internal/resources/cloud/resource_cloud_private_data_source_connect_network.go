@@ -0,0 +1,219 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	resourcePDCNetworkID = common.NewResourceID(
+		common.StringIDField("region"),
+		common.StringIDField("pdcNetworkId"),
+	)
+
+	// The scope granted to the tokens of a PDC network, allowing the PDC agent to connect to the stack
+	pdcNetworkScopes = []string{"set:pdc-signing"}
+)
+
+func resourcePDCNetwork() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages a Private Data source Connect (PDC) network, which allows the data sources of a Grafana Cloud stack to query data sources in private networks, through a PDC agent.
+A PDC network is an access policy, dedicated to the PDC agent. Create a ` + "`grafana_cloud_private_data_source_connect_network_token`" + ` resource to get a token for the agent.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/connect-externally-hosted/private-data-source-connect/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#create-an-access-policy)
+
+Required access policy scopes:
+
+* accesspolicies:read
+* accesspolicies:write
+* accesspolicies:delete
+* stacks:read
+`,
+
+		CreateContext: withClient[schema.CreateContextFunc](createPDCNetwork),
+		UpdateContext: withClient[schema.UpdateContextFunc](updatePDCNetwork),
+		DeleteContext: withClient[schema.DeleteContextFunc](deletePDCNetwork),
+		ReadContext:   withClient[schema.ReadContextFunc](readPDCNetwork),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region where the API is deployed. Generally where the stack is deployed. Use the region list API to get the list of available regions: https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-regions.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the PDC network.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Display name of the PDC network. Defaults to the name.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if new == "" && old == d.Get("name").(string) {
+						return true
+					}
+					return false
+				},
+			},
+			"stack_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the stack whose data sources use the PDC network.",
+			},
+
+			// Computed
+			"pdc_network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the PDC network.",
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cluster of the stack. Used to configure the PDC agent (`-cluster` flag).",
+			},
+			"hosted_grafana_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Grafana instance of the stack. Used to configure the PDC agent (`-gcloud-hosted-grafana-id` flag).",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the PDC network.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update date of the PDC network.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryCloud,
+		"grafana_cloud_private_data_source_connect_network",
+		resourcePDCNetworkID,
+		schema,
+	)
+}
+
+func createPDCNetwork(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	region := d.Get("region").(string)
+
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = d.Get("name").(string)
+	}
+
+	req := client.AccesspoliciesAPI.PostAccessPolicies(ctx).Region(region).XRequestId(ClientRequestID()).
+		PostAccessPoliciesRequest(gcom.PostAccessPoliciesRequest{
+			Name:        d.Get("name").(string),
+			DisplayName: &displayName,
+			Scopes:      pdcNetworkScopes,
+			Realms: []gcom.PostAccessPoliciesRequestRealmsInner{
+				{
+					Type:          "stack",
+					Identifier:    d.Get("stack_identifier").(string),
+					LabelPolicies: []gcom.PostAccessPoliciesRequestRealmsInnerLabelPoliciesInner{},
+				},
+			},
+		})
+	result, _, err := req.Execute()
+	if err != nil {
+		return apiError(err)
+	}
+
+	d.SetId(resourcePDCNetworkID.Make(region, result.Id))
+
+	return readPDCNetwork(ctx, d, client)
+}
+
+func updatePDCNetwork(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	split, err := resourcePDCNetworkID.Split(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region, id := split[0], split[1]
+
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = d.Get("name").(string)
+	}
+
+	req := client.AccesspoliciesAPI.PostAccessPolicy(ctx, id.(string)).Region(region.(string)).XRequestId(ClientRequestID()).
+		PostAccessPolicyRequest(gcom.PostAccessPolicyRequest{
+			DisplayName: &displayName,
+		})
+	if _, _, err = req.Execute(); err != nil {
+		return apiError(err)
+	}
+
+	return readPDCNetwork(ctx, d, client)
+}
+
+func readPDCNetwork(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	split, err := resourcePDCNetworkID.Split(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region, id := split[0], split[1]
+
+	result, _, err := client.AccesspoliciesAPI.GetAccessPolicy(ctx, id.(string)).Region(region.(string)).Execute()
+	if err, shouldReturn := common.CheckReadError("PDC network", d, err); shouldReturn {
+		return err
+	}
+	if len(result.Realms) != 1 || result.Realms[0].GetType() != "stack" {
+		return diag.Errorf("access policy %s is not a PDC network: it must have a single stack realm", id)
+	}
+	stackIdentifier := result.Realms[0].GetIdentifier()
+
+	stack, _, err := client.InstancesAPI.GetInstance(ctx, stackIdentifier).Execute()
+	if err != nil {
+		return apiError(fmt.Errorf("failed to get the stack of the PDC network: %w", err))
+	}
+
+	d.Set("region", region)
+	d.Set("pdc_network_id", result.Id)
+	d.Set("name", result.Name)
+	d.Set("display_name", result.DisplayName)
+	d.Set("stack_identifier", stackIdentifier)
+	d.Set("cluster", stack.ClusterSlug)
+	d.Set("hosted_grafana_id", strconv.FormatInt(int64(stack.Id), 10))
+	d.Set("created_at", result.CreatedAt.Format(time.RFC3339))
+	if updated := result.UpdatedAt; updated != nil {
+		d.Set("updated_at", updated.Format(time.RFC3339))
+	}
+	d.SetId(resourcePDCNetworkID.Make(region, result.Id))
+
+	return nil
+}
+
+func deletePDCNetwork(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	split, err := resourcePDCNetworkID.Split(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region, id := split[0], split[1]
+
+	_, _, err = client.AccesspoliciesAPI.DeleteAccessPolicy(ctx, id.(string)).Region(region.(string)).XRequestId(ClientRequestID()).Execute()
+	return apiError(err)
+}
@@ -0,0 +1,76 @@
+package cloud_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPDCNetwork_basic(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var stack gcom.FormattedApiInstance
+	prefix := "tfpdctest"
+	slug := GetRandomStackName(prefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccDeleteExistingStacks(t, prefix)
+		},
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccStackCheckDestroy(&stack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPDCNetworkConfig(slug, "PDC network"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					resource.TestCheckResourceAttr("grafana_cloud_private_data_source_connect_network.test", "name", slug+"-pdc"),
+					resource.TestCheckResourceAttr("grafana_cloud_private_data_source_connect_network.test", "display_name", "PDC network"),
+					resource.TestCheckResourceAttrPair("grafana_cloud_private_data_source_connect_network.test", "stack_identifier", "grafana_cloud_stack.test", "id"),
+					resource.TestCheckResourceAttrSet("grafana_cloud_private_data_source_connect_network.test", "cluster"),
+					resource.TestCheckResourceAttrPair("grafana_cloud_private_data_source_connect_network.test", "hosted_grafana_id", "grafana_cloud_stack.test", "id"),
+					resource.TestCheckResourceAttrSet("grafana_cloud_private_data_source_connect_network.test", "pdc_network_id"),
+					resource.TestCheckResourceAttrPair("grafana_cloud_private_data_source_connect_network_token.test", "pdc_network_id", "grafana_cloud_private_data_source_connect_network.test", "pdc_network_id"),
+					resource.TestCheckResourceAttrSet("grafana_cloud_private_data_source_connect_network_token.test", "token"),
+				),
+			},
+			{
+				Config: testAccPDCNetworkConfig(slug, "PDC network updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_cloud_private_data_source_connect_network.test", "display_name", "PDC network updated"),
+				),
+			},
+			{
+				ResourceName:      "grafana_cloud_private_data_source_connect_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "grafana_cloud_private_data_source_connect_network_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccPDCNetworkConfig(slug, displayName string) string {
+	return testAccStackConfigBasic(slug, slug, "description") + fmt.Sprintf(`
+	resource "grafana_cloud_private_data_source_connect_network" "test" {
+		region           = "eu"
+		name             = "%[1]s-pdc"
+		display_name     = "%[2]s"
+		stack_identifier = grafana_cloud_stack.test.id
+	}
+
+	resource "grafana_cloud_private_data_source_connect_network_token" "test" {
+		region         = grafana_cloud_private_data_source_connect_network.test.region
+		pdc_network_id = grafana_cloud_private_data_source_connect_network.test.pdc_network_id
+		name           = "%[1]s-pdc-token"
+	}
+	`, slug, displayName)
+}
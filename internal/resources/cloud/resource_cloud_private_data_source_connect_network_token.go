@@ -0,0 +1,59 @@
+package cloud
+
+import (
+	"context"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePDCNetworkToken() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages a token of a Private Data source Connect (PDC) network. The token is used by the PDC agent to connect to the network (` + "`-token`" + ` flag).
+Like access policy tokens, it can be rotated with ` + "`rotate_after`" + `.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/connect-externally-hosted/private-data-source-connect/configure-pdc/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#create-a-token)
+
+Required access policy scopes:
+
+* accesspolicies:read
+* accesspolicies:write
+* accesspolicies:delete
+`,
+
+		CreateContext: withClient[schema.CreateContextFunc](createPDCNetworkToken),
+		UpdateContext: withClient[schema.UpdateContextFunc](updatePDCNetworkToken),
+		DeleteContext: withClient[schema.DeleteContextFunc](deleteCloudAccessPolicyToken),
+		ReadContext:   withClient[schema.ReadContextFunc](readPDCNetworkToken),
+		CustomizeDiff: accessPolicyTokenCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: accessPolicyTokenSchema("pdc_network_id", "ID of the PDC network for which to create a token."),
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryCloud,
+		"grafana_cloud_private_data_source_connect_network_token",
+		resourceAccessPolicyTokenID,
+		schema,
+	)
+}
+
+func createPDCNetworkToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return createToken(ctx, d, client, "pdc_network_id")
+}
+
+func updatePDCNetworkToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return updateToken(ctx, d, client, "pdc_network_id")
+}
+
+func readPDCNetworkToken(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	return readToken(ctx, d, client, "pdc_network_id")
+}
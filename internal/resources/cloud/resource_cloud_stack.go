@@ -3,6 +3,7 @@ package cloud
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -11,7 +12,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
 	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -20,7 +24,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const defaultReadinessTimeout = time.Minute * 5
+const (
+	defaultReadinessTimeout = time.Minute * 5
+
+	stackReadinessCheckSyntheticMonitoring = "synthetic_monitoring"
+	stackReadinessCheckOnCall              = "oncall"
+)
 
 var (
 	stackLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9/\-.]+$`)
@@ -112,10 +121,35 @@ Required access policy scopes:
 				},
 				Description: "How long to wait for readiness (if enabled).",
 			},
+			"wait_for_readiness_checks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				// Only used when wait_for_readiness is true
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return !d.Get("wait_for_readiness").(bool)
+				},
+				Description: "The services of the stack to wait for, in addition to the Grafana instance (if readiness is enabled). " +
+					"Supported values are `synthetic_monitoring` (the Synthetic Monitoring app of the stack) and `oncall` (the OnCall API set in the settings of the stack's OnCall app). A temporary service account is created in the stack to run these checks. " +
+					"Useful when the same apply creates resources of these services in the stack.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{stackReadinessCheckSyntheticMonitoring, stackReadinessCheckOnCall}, false),
+				},
+			},
 			"org_id":   common.ComputedIntWithDescription("Organization id to assign to this stack."),
 			"org_slug": common.ComputedStringWithDescription("Organization slug to assign to this stack."),
 			"org_name": common.ComputedStringWithDescription("Organization name to assign to this stack."),
 			"status":   common.ComputedStringWithDescription("Status of the stack."),
+			"plan": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The plan of the stack, which determines its limits. Defaults to the plan of the organization.",
+			},
+			"plan_name":       common.ComputedStringWithDescription("Display name of the plan of the stack."),
+			"alert_quota":     common.ComputedIntWithDescription("Maximum number of alert rules of the stack, as set by its plan."),
+			"dashboard_quota": common.ComputedIntWithDescription("Maximum number of dashboards of the stack, as set by its plan."),
+			"user_quota":      common.ComputedIntWithDescription("Maximum number of users of the stack, as set by its plan."),
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -179,6 +213,9 @@ Required access policy scopes:
 			// Connections
 			"influx_url": common.ComputedStringWithDescription("Base URL of the InfluxDB instance configured for this stack. The username is the same as the metrics' (`prometheus_user_id` attribute of this resource). See https://grafana.com/docs/grafana-cloud/send-data/metrics/metrics-influxdb/push-from-telegraf/ for docs on how to use this."),
 			"otlp_url":   common.ComputedStringWithDescription("Base URL of the OTLP instance configured for this stack. The username is the stack's ID (`id` attribute of this resource). See https://grafana.com/docs/grafana-cloud/send-data/otlp/send-data-otlp/ for docs on how to use this."),
+
+			// Synthetic Monitoring
+			"synthetic_monitoring_api_url": common.ComputedStringWithDescription("URL of the Synthetic Monitoring API of the stack's region. This can be used as the `sm_url` of the provider."),
 		},
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("url", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
@@ -229,6 +266,9 @@ func createStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		Description: common.Ref(d.Get("description").(string)),
 		Labels:      common.Ref(common.UnpackMap[string](d.Get("labels"))),
 	}
+	if plan := d.Get("plan").(string); plan != "" {
+		stack.Plan = &plan
+	}
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		req := client.InstancesAPI.PostInstances(ctx).PostInstancesRequest(stack).XRequestId(ClientRequestID())
@@ -264,14 +304,7 @@ func createStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		return diag
 	}
 
-	if d.Get("wait_for_readiness").(bool) {
-		timeout := defaultReadinessTimeout
-		if timeoutVal := d.Get("wait_for_readiness_timeout").(string); timeoutVal != "" {
-			timeout, _ = time.ParseDuration(timeoutVal)
-		}
-		return waitForStackReadiness(ctx, timeout, d.Get("url").(string))
-	}
-	return nil
+	return waitForStackReadinessFromResourceData(ctx, d, client)
 }
 
 func updateStack(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
@@ -293,6 +326,9 @@ func updateStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		Url:         &url,
 		Labels:      common.Ref(common.UnpackMap[string](d.Get("labels"))),
 	}
+	if d.HasChange("plan") {
+		stack.Plan = common.Ref(d.Get("plan").(string))
+	}
 	req := client.InstancesAPI.PostInstance(ctx, id.(string)).PostInstanceRequest(stack).XRequestId(ClientRequestID())
 	_, _, err = req.Execute()
 	if err != nil {
@@ -303,14 +339,7 @@ func updateStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		return diag
 	}
 
-	if d.Get("wait_for_readiness").(bool) {
-		timeout := defaultReadinessTimeout
-		if timeoutVal := d.Get("wait_for_readiness_timeout").(string); timeoutVal != "" {
-			timeout, _ = time.ParseDuration(timeoutVal)
-		}
-		return waitForStackReadiness(ctx, timeout, d.Get("url").(string))
-	}
-	return nil
+	return waitForStackReadinessFromResourceData(ctx, d, client)
 }
 
 func deleteStack(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
@@ -369,6 +398,11 @@ func flattenStack(d *schema.ResourceData, stack *gcom.FormattedApiInstance, conn
 	d.Set("region_slug", stack.RegionSlug)
 	d.Set("description", stack.Description)
 	d.Set("labels", stack.Labels)
+	d.Set("plan", stack.Plan)
+	d.Set("plan_name", stack.PlanName)
+	d.Set("alert_quota", int(stack.AlertQuota))
+	d.Set("dashboard_quota", int(stack.DashboardQuota))
+	d.Set("user_quota", int(stack.UserQuota))
 
 	d.Set("org_id", stack.OrgId)
 	d.Set("org_slug", stack.OrgSlug)
@@ -414,6 +448,10 @@ func flattenStack(d *schema.ResourceData, stack *gcom.FormattedApiInstance, conn
 	d.Set("graphite_url", stack.HmInstanceGraphiteUrl)
 	d.Set("graphite_status", stack.HmInstanceGraphiteStatus)
 
+	// The URL is empty if the region is unknown, the installation resource surfaces the error
+	smAPIURL, _ := stackSMAPIURL(stack)
+	d.Set("synthetic_monitoring_api_url", smAPIURL)
+
 	if otlpURL := connections.OtlpHttpUrl; otlpURL.IsSet() {
 		d.Set("otlp_url", otlpURL.Get())
 	}
@@ -487,6 +525,124 @@ func waitForStackReadiness(ctx context.Context, timeout time.Duration, stackURL
 	return nil
 }
 
+// waitForStackReadinessFromResourceData waits for the stack and the services set in `wait_for_readiness_checks`, if readiness is enabled.
+func waitForStackReadinessFromResourceData(ctx context.Context, d *schema.ResourceData, client *gcom.APIClient) diag.Diagnostics {
+	if !d.Get("wait_for_readiness").(bool) {
+		return nil
+	}
+
+	timeout := defaultReadinessTimeout
+	if timeoutVal := d.Get("wait_for_readiness_timeout").(string); timeoutVal != "" {
+		timeout, _ = time.ParseDuration(timeoutVal)
+	}
+	deadline := time.Now().Add(timeout)
+
+	if diag := waitForStackReadiness(ctx, timeout, d.Get("url").(string)); diag != nil {
+		return diag
+	}
+
+	checks := common.SetToStringSlice(d.Get("wait_for_readiness_checks").(*schema.Set))
+	if len(checks) == 0 {
+		return nil
+	}
+	stackClient, cleanup, err := CreateTemporaryStackGrafanaClient(ctx, client, d.Id(), "terraform-readiness-")
+	if err != nil {
+		return diag.Errorf("failed to create a temporary client for the stack: %v", err)
+	}
+	defer cleanup()
+
+	for _, check := range checks {
+		var readinessCheck func() error
+		switch check {
+		case stackReadinessCheckSyntheticMonitoring:
+			// The stack is ready once its Synthetic Monitoring app is available
+			readinessCheck = func() error {
+				_, err := stackAppPluginJSONData(ctx, stackClient, "grafana-synthetic-monitoring-app")
+				return err
+			}
+		case stackReadinessCheckOnCall:
+			// The OnCall API of the stack is set in the settings of its OnCall app
+			readinessCheck = func() error {
+				jsonData, err := stackAppPluginJSONData(ctx, stackClient, "grafana-oncall-app")
+				if err != nil {
+					return err
+				}
+				apiURL, _ := jsonData["onCallApiUrl"].(string)
+				if apiURL == "" {
+					return errors.New("the OnCall API URL is not set in the settings of the OnCall app")
+				}
+				return checkStackServiceHealth(ctx, strings.TrimSuffix(apiURL, "/")+"/health/")
+			}
+		}
+		if diag := waitForStackServiceReadiness(ctx, time.Until(deadline), check, readinessCheck); diag != nil {
+			return diag
+		}
+	}
+
+	return nil
+}
+
+// waitForStackServiceReadiness retries the readiness check of a service used by the stack until it succeeds.
+func waitForStackServiceReadiness(ctx context.Context, timeout time.Duration, service string, readinessCheck func() error) diag.Diagnostics {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := readinessCheck(); err != nil {
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error waiting for %s to be ready: %v", service, err)
+	}
+
+	return nil
+}
+
+// checkStackServiceHealth checks that the health endpoint of a service responds successfully.
+func checkStackServiceHealth(ctx context.Context, healthURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with status code %d", healthURL, resp.StatusCode)
+	}
+	return nil
+}
+
+// stackAppPluginJSONData reads the JSON data of an app plugin of the stack. Plugin settings are not part of the generated client.
+func stackAppPluginJSONData(ctx context.Context, client *goapi.GrafanaHTTPAPI, pluginID string) (map[string]interface{}, error) {
+	var settings struct {
+		JSONData map[string]interface{} `json:"jsonData"`
+	}
+	_, err := client.Transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetPluginSettingByID",
+		Method:             http.MethodGet,
+		PathPattern:        "/plugins/" + url.PathEscape(pluginID) + "/settings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params: runtime.ClientRequestWriterFunc(func(runtime.ClientRequest, strfmt.Registry) error {
+			return nil
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if resp.Code() < http.StatusOK || resp.Code() >= http.StatusMultipleChoices {
+				return nil, runtime.NewAPIError("GetPluginSettingByID", resp.Message(), resp.Code())
+			}
+			return nil, consumer.Consume(resp.Body(), &settings)
+		}),
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the settings of the %s app: %w", pluginID, err)
+	}
+	return settings.JSONData, nil
+}
+
 func waitForStackReadinessFromSlug(ctx context.Context, timeout time.Duration, slug string, client *gcom.APIClient) diag.Diagnostics {
 	stack, _, err := client.InstancesAPI.GetInstance(ctx, slug).Execute()
 	if err != nil {
@@ -42,6 +42,9 @@ func TestResourceStack_Basic(t *testing.T) {
 		resource.TestCheckResourceAttr("grafana_cloud_stack.test", "prometheus_remote_endpoint", "https://prometheus-prod-01-eu-west-0.grafana.net/api/prom"),
 		resource.TestCheckResourceAttr("grafana_cloud_stack.test", "prometheus_remote_write_endpoint", "https://prometheus-prod-01-eu-west-0.grafana.net/api/prom/push"),
 		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "prometheus_user_id"),
+		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "plan"),
+		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "plan_name"),
+		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "dashboard_quota"),
 		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "alertmanager_user_id"),
 		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "logs_user_id"),
 		resource.TestCheckResourceAttrSet("grafana_cloud_stack.test", "traces_user_id"),
@@ -125,6 +128,16 @@ func TestResourceStack_Basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Wait for the services of the stack
+			{
+				Config: testAccStackConfigReadinessChecks(resourceName+"new", resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "wait_for_readiness_checks.#", "2"),
+					resource.TestCheckTypeSetElemAttr("grafana_cloud_stack.test", "wait_for_readiness_checks.*", "synthetic_monitoring"),
+					resource.TestCheckTypeSetElemAttr("grafana_cloud_stack.test", "wait_for_readiness_checks.*", "oncall"),
+				),
+			},
 		},
 	})
 }
@@ -275,6 +288,19 @@ func testAccStackConfigUpdate(name string, slug string, description string) stri
 	`, name, slug, description)
 }
 
+func testAccStackConfigReadinessChecks(name string, slug string) string {
+	return fmt.Sprintf(`
+	resource "grafana_cloud_stack" "test" {
+		name        = "%s"
+		slug        = "%s"
+		region_slug = "eu"
+
+		wait_for_readiness_timeout = "10m"
+		wait_for_readiness_checks  = ["synthetic_monitoring", "oncall"]
+	}
+	`, name, slug)
+}
+
 // Prefix a character as stack name can't start with a number
 func GetRandomStackName(prefix string) string {
 	return prefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -20,6 +20,18 @@ var smAPIURLsExceptions = map[string]string{
 	"us-azure":        "https://synthetic-monitoring-api-us-central2.grafana.net",
 }
 
+// stackSMAPIURL returns the URL of the SM API of the stack's region.
+// The URL is returned by the API for most regions, the static mapping is used as a fallback.
+func stackSMAPIURL(stack *gcom.FormattedApiInstance) (string, error) {
+	if stack.RegionSyntheticMonitoringApiUrl != "" {
+		return stack.RegionSyntheticMonitoringApiUrl, nil
+	}
+	if apiURL, ok := smAPIURLsExceptions[stack.RegionSlug]; ok {
+		return apiURL, nil
+	}
+	return "", fmt.Errorf("could not find the Synthetic Monitoring API URL of the %q region, set it with the `stack_sm_api_url` attribute. See https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/set-up/set-up-private-probes/#probe-api-server-url for the list of API URLs", stack.RegionSlug)
+}
+
 func resourceSyntheticMonitoringInstallation() *common.Resource {
 	schema := &schema.Resource{
 
@@ -54,7 +66,7 @@ Required access policy scopes:
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Description: "The URL of the SM API to install SM on. This depends on the stack region, find the list of API URLs here: https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/set-up/set-up-private-probes/#probe-api-server-url. A static mapping exists in the provider but it may not contain all the regions. If it does contain the stack's region, this field is computed automatically and readable.",
+				Description: "The URL of the SM API to install SM on. This depends on the stack region, find the list of API URLs here: https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/set-up/set-up-private-probes/#probe-api-server-url. By default, this field is computed automatically from the stack's region. An error is returned if the URL of the region can't be found, in which case it must be set.",
 			},
 			"stack_id": {
 				Type:        schema.TypeString,
@@ -85,14 +97,11 @@ func resourceInstallationCreate(ctx context.Context, d *schema.ResourceData, clo
 		return apiError(err)
 	}
 
-	// TODO: Get this URL programatically
-	// Perhaps it should be exposed to users through /api/stack-regions?
 	apiURL := d.Get("stack_sm_api_url").(string)
 	if apiURL == "" {
-		apiURL = smAPIURLsExceptions[stack.RegionSlug]
-	}
-	if apiURL == "" {
-		apiURL = fmt.Sprintf("https://synthetic-monitoring-api-%s.grafana.net", strings.TrimPrefix(stack.RegionSlug, "prod-"))
+		if apiURL, err = stackSMAPIURL(stack); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	smClient := SMAPI.NewClient(apiURL, "", nil)
@@ -36,6 +36,7 @@ func TestAccSyntheticMonitoringInstallation(t *testing.T) {
 							testAccStackCheckExists("grafana_cloud_stack.test", &stack),
 							resource.TestCheckResourceAttrSet("grafana_synthetic_monitoring_installation.test", "sm_access_token"),
 							resource.TestCheckResourceAttr("grafana_synthetic_monitoring_installation.test", "stack_sm_api_url", expectedURL),
+							resource.TestCheckResourceAttr("grafana_cloud_stack.test", "synthetic_monitoring_api_url", expectedURL),
 						),
 					},
 					// Test deletion
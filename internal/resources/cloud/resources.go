@@ -8,13 +8,17 @@ var DataSources = []*common.DataSource{
 	datasourceAccessPolicies(),
 	datasourceIPs(),
 	datasourceOrganization(),
+	datasourceOrgMembers(),
 	datasourceStack(),
+	datasourceStacks(),
 }
 
 var Resources = []*common.Resource{
 	resourceAccessPolicy(),
 	resourceAccessPolicyToken(),
 	resourceOrgMember(),
+	resourcePDCNetwork(),
+	resourcePDCNetworkToken(),
 	resourcePluginInstallation(),
 	resourceStack(),
 	resourceStackServiceAccount(),
@@ -0,0 +1,55 @@
+package grafana
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+)
+
+// submitAPIRequest sends a request to a Grafana API that is not part of the generated client (eg. the Alertmanager API).
+// The path is relative to the API root (eg. `/alertmanager/grafana/api/v2/silences`). Use `/../apis/...` for the app platform APIs.
+// If result is not nil, the response body is decoded into it.
+func submitAPIRequest(ctx context.Context, client *goapi.GrafanaHTTPAPI, id, method, path string, body, result interface{}) error {
+	return submitAPIRequestWithQuery(ctx, client, id, method, path, nil, body, result)
+}
+
+// submitAPIRequestWithQuery is submitAPIRequest with query parameters, for APIs that the generated client exposes without them.
+func submitAPIRequestWithQuery(ctx context.Context, client *goapi.GrafanaHTTPAPI, id, method, path string, query url.Values, body, result interface{}) error {
+	op := &runtime.ClientOperation{
+		ID:                 id,
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params: runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+			for key, values := range query {
+				if err := req.SetQueryParam(key, values...); err != nil {
+					return err
+				}
+			}
+			if body == nil {
+				return nil
+			}
+			return req.SetBodyParam(body)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if resp.Code() < http.StatusOK || resp.Code() >= http.StatusMultipleChoices {
+				return nil, runtime.NewAPIError(id, resp.Message(), resp.Code())
+			}
+			if result != nil {
+				if err := consumer.Consume(resp.Body(), result); err != nil {
+					return nil, err
+				}
+			}
+			return result, nil
+		}),
+		Context: ctx,
+	}
+	_, err := client.Transport.Submit(op)
+	return err
+}
@@ -2,14 +2,17 @@ package grafana_test
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/annotations"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/client/snapshots"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/resources/grafana"
@@ -66,6 +69,35 @@ var (
 			return tree, nil
 		},
 	)
+	alertingNotificationPolicyRouteCheckExists = newCheckExistsHelper(
+		notificationPolicyRouteID,
+		func(client *goapi.GrafanaHTTPAPI, id string) (*models.Route, error) {
+			resp, err := client.Provisioning.GetPolicyTree()
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Payload.Routes {
+				if notificationPolicyRouteID(r) == id {
+					return r, nil
+				}
+			}
+			return nil, &runtime.APIError{Code: 404, Response: "no top-level notification policy with matchers " + id}
+		},
+	)
+	alertingSilenceCheckExists = newCheckExistsHelper(
+		func(s *models.GettableSilence) string { return *s.ID },
+		func(client *goapi.GrafanaHTTPAPI, id string) (*models.GettableSilence, error) {
+			var silence models.GettableSilence
+			if err := getFromAPI(client, "/alertmanager/grafana/api/v2/silence/"+id, &silence); err != nil {
+				return nil, err
+			}
+			// Deleted silences are expired, until the Alertmanager removes them
+			if silence.Status != nil && *silence.Status.State == "expired" {
+				return nil, &runtime.APIError{Code: 404, Response: "silence expired"}
+			}
+			return &silence, nil
+		},
+	)
 	alertingRuleGroupCheckExists = newCheckExistsHelper(
 		func(g *models.AlertRuleGroup) string { return g.FolderUID + ":" + g.Title },
 		func(client *goapi.GrafanaHTTPAPI, id string) (*models.AlertRuleGroup, error) {
@@ -101,6 +133,21 @@ var (
 			return payloadOrError(resp, err)
 		},
 	)
+	dashboardSnapshotCheckExists = newCheckExistsHelper(
+		func(d *models.DashboardSnapshotDTO) string { return d.Key },
+		func(client *goapi.GrafanaHTTPAPI, id string) (*models.DashboardSnapshotDTO, error) {
+			resp, err := client.Snapshots.SearchDashboardSnapshots(snapshots.NewSearchDashboardSnapshotsParams())
+			if err != nil {
+				return nil, err
+			}
+			for _, snapshot := range resp.Payload {
+				if snapshot.Key == id {
+					return snapshot, nil
+				}
+			}
+			return nil, &runtime.APIError{Code: 404}
+		},
+	)
 	dashboardPublicCheckExists = newCheckExistsHelper(
 		func(d *models.PublicDashboard) string { return d.DashboardUID + ":" + d.UID },
 		func(client *goapi.GrafanaHTTPAPI, id string) (*models.PublicDashboard, error) {
@@ -116,6 +163,29 @@ var (
 			return payloadOrError(resp, err)
 		},
 	)
+	datasourceLBACRulesCheckExists = newCheckExistsHelper(
+		datasourceCheckExists.getIDFunc, // We use the DS as the reference
+		func(client *goapi.GrafanaHTTPAPI, uid string) (*models.DataSource, error) {
+			ds, err := datasourceCheckExists.getResourceFunc(client, uid)
+			if err != nil {
+				return nil, err
+			}
+			var rules struct {
+				Rules []struct {
+					Rules []string `json:"rules"`
+				} `json:"rules"`
+			}
+			if err := getFromAPI(client, "/datasources/uid/"+uid+"/lbac/teams", &rules); err != nil {
+				return nil, err
+			}
+			for _, teamRules := range rules.Rules {
+				if len(teamRules.Rules) > 0 {
+					return ds, nil
+				}
+			}
+			return nil, &runtime.APIError{Code: 404, Response: "no LBAC rules found"}
+		},
+	)
 	datasourcePermissionsCheckExists = newCheckExistsHelper(
 		datasourceCheckExists.getIDFunc, // We use the DS as the reference
 		func(client *goapi.GrafanaHTTPAPI, uid string) (*models.DataSource, error) {
@@ -348,3 +418,24 @@ func payloadOrError[T interface{}, R interface{ GetPayload() *T }](resp R, err e
 	}
 	return resp.GetPayload(), nil
 }
+
+// getFromAPI decodes the response of a Grafana API that is not part of the generated client into result.
+// A 404 is returned as a *runtime.APIError, so that it is recognized as a not found error.
+func getFromAPI(client *goapi.GrafanaHTTPAPI, path string, result interface{}) error {
+	_, err := client.Transport.Submit(&runtime.ClientOperation{
+		ID:                 "getFromAPI",
+		Method:             http.MethodGet,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             runtime.ClientRequestWriterFunc(func(runtime.ClientRequest, strfmt.Registry) error { return nil }),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if resp.Code() < http.StatusOK || resp.Code() >= http.StatusMultipleChoices {
+				return nil, runtime.NewAPIError("getFromAPI", resp.Message(), resp.Code())
+			}
+			return nil, consumer.Consume(resp.Body(), result)
+		}),
+	})
+	return err
+}
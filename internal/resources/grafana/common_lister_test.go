@@ -0,0 +1,169 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"testing"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGrafanaServer serves the paginated listing APIs of Grafana from a large synthetic dataset.
+type fakeGrafanaServer struct {
+	dashboards    int
+	folders       int
+	libraryPanels int
+	annotations   int
+	requests      map[string]int
+}
+
+func (f *fakeGrafanaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests[r.URL.Path]++
+	query := r.URL.Query()
+	var result interface{}
+	switch r.URL.Path {
+	case "/api/search":
+		size, prefix := f.dashboards, "dashboard"
+		if query.Get("type") == "dash-folder" {
+			size, prefix = f.folders, "folder"
+		}
+		start, end := pageBounds(query, "limit", "page", 1000, size)
+		hits := []map[string]interface{}{}
+		for i := start; i < end; i++ {
+			hits = append(hits, map[string]interface{}{"uid": fmt.Sprintf("%s-%d", prefix, i)})
+		}
+		result = hits
+	case "/api/library-elements":
+		start, end := pageBounds(query, "perPage", "page", 100, f.libraryPanels)
+		elements := []map[string]interface{}{}
+		for i := start; i < end; i++ {
+			elements = append(elements, map[string]interface{}{"uid": fmt.Sprintf("panel-%d", i)})
+		}
+		result = map[string]interface{}{"result": map[string]interface{}{"totalCount": f.libraryPanels, "elements": elements}}
+	case "/api/annotations":
+		result = f.annotationsPage(query)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// annotationsPage returns the annotations up to the `to` time, from the most recent to the oldest.
+// Several annotations share the same time, as in the real API.
+func (f *fakeGrafanaServer) annotationsPage(query url.Values) []map[string]interface{} {
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	to := int64(-1)
+	if v := query.Get("to"); v != "" {
+		to, _ = strconv.ParseInt(v, 10, 64)
+	}
+	ids := make([]int, 0, f.annotations)
+	for id := 1; id <= f.annotations; id++ {
+		if to < 0 || annotationTime(id) <= to {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	page := []map[string]interface{}{}
+	for _, id := range ids {
+		page = append(page, map[string]interface{}{"id": id, "time": annotationTime(id)})
+	}
+	return page
+}
+
+func annotationTime(id int) int64 {
+	return int64(id / 3)
+}
+
+func pageBounds(query url.Values, limitParam, pageParam string, defaultLimit, size int) (int, int) {
+	limit, err := strconv.Atoi(query.Get(limitParam))
+	if err != nil {
+		limit = defaultLimit
+	}
+	page, err := strconv.Atoi(query.Get(pageParam))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	start, end := (page-1)*limit, page*limit
+	if start > size {
+		start = size
+	}
+	if end > size {
+		end = size
+	}
+	return start, end
+}
+
+func newFakeGrafanaClient(t *testing.T, fake *fakeGrafanaServer) *goapi.GrafanaHTTPAPI {
+	t.Helper()
+	fake.requests = map[string]int{}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return goapi.NewHTTPClientWithConfig(nil, &goapi.TransportConfig{
+		Host:     serverURL.Host,
+		BasePath: "/api",
+		Schemes:  []string{"http"},
+	})
+}
+
+func requireUniqueIDs(t *testing.T, ids []string, expected int) {
+	t.Helper()
+	require.Len(t, ids, expected)
+	unique := map[string]bool{}
+	for _, id := range ids {
+		unique[id] = true
+	}
+	require.Len(t, unique, expected, "duplicate IDs were listed")
+}
+
+func TestListersPaginateLargeDatasets(t *testing.T) {
+	fake := &fakeGrafanaServer{
+		dashboards:    2500,
+		folders:       1000, // Exactly one full page, the next one is empty
+		libraryPanels: 250,
+		annotations:   2500,
+	}
+	client := newFakeGrafanaClient(t, fake)
+
+	t.Run("dashboards", func(t *testing.T) {
+		ids, err := listDashboards(context.Background(), client, 1)
+		require.NoError(t, err)
+		requireUniqueIDs(t, ids, fake.dashboards)
+		require.Contains(t, ids, "1:dashboard-0")
+		require.Contains(t, ids, fmt.Sprintf("1:dashboard-%d", fake.dashboards-1))
+	})
+
+	t.Run("folders", func(t *testing.T) {
+		ids, err := listDashboardOrFolder(client, 1, "dash-folder")
+		require.NoError(t, err)
+		requireUniqueIDs(t, ids, fake.folders)
+	})
+
+	t.Run("library panels", func(t *testing.T) {
+		ids, err := listLibraryPanels(context.Background(), client, 1)
+		require.NoError(t, err)
+		requireUniqueIDs(t, ids, fake.libraryPanels)
+		require.Equal(t, 3, fake.requests["/api/library-elements"])
+	})
+
+	t.Run("annotations", func(t *testing.T) {
+		ids, err := listAnnotations(context.Background(), client, 1)
+		require.NoError(t, err)
+		requireUniqueIDs(t, ids, fake.annotations)
+		require.Contains(t, ids, "1:1")
+		require.Contains(t, ids, fmt.Sprintf("1:%d", fake.annotations))
+	})
+}
@@ -4,10 +4,13 @@
 package grafana
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/client/teams"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,19 +28,27 @@ const (
 	permissionTargetTeam = "team"
 	permissionTargetUser = "user"
 
+	// Only supported by the resources that set serviceAccountTarget
+	permissionTargetServiceAccount = "service_account"
+
 	dashboardsPermissionsType      = "dashboards"
 	datasourcesPermissionsType     = "datasources"
 	foldersPermissionsType         = "folders"
 	serviceAccountsPermissionsType = "serviceaccounts"
+
+	// Prefixes used to reference teams and service accounts by name instead of ID
+	teamReferencePrefix           = "team:"
+	serviceAccountReferencePrefix = "sa:"
 )
 
 type resourcePermissionItemBaseModel struct {
-	ID         types.String `tfsdk:"id"`
-	OrgID      types.String `tfsdk:"org_id"`
-	Role       types.String `tfsdk:"role"`
-	Team       types.String `tfsdk:"team"`
-	User       types.String `tfsdk:"user"`
-	Permission types.String `tfsdk:"permission"`
+	ID             types.String `tfsdk:"id"`
+	OrgID          types.String `tfsdk:"org_id"`
+	Role           types.String `tfsdk:"role"`
+	Team           types.String `tfsdk:"team"`
+	User           types.String `tfsdk:"user"`
+	Permission     types.String `tfsdk:"permission"`
+	ServiceAccount types.String `tfsdk:"service_account"`
 
 	// Framework doesn't support embedding a base struct: https://github.com/hashicorp/terraform-plugin-framework/issues/242
 	// So this is a generic ID to be written to FolderUID/DatasourceUID/etc
@@ -47,14 +58,21 @@ type resourcePermissionItemBaseModel struct {
 type resourcePermissionBase struct {
 	basePluginFrameworkResource
 	resourceType string
+
+	// Whether the resource has a service_account attribute, to assign permissions to service accounts other than through the user attribute
+	serviceAccountTarget bool
 }
 
 func (r *resourcePermissionBase) addInSchemaAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
-	targetOneOf := stringvalidator.ExactlyOneOf(
+	targetPaths := []path.Expression{
 		path.MatchRoot(permissionTargetRole),
 		path.MatchRoot(permissionTargetTeam),
 		path.MatchRoot(permissionTargetUser),
-	)
+	}
+	if r.serviceAccountTarget {
+		targetPaths = append(targetPaths, path.MatchRoot(permissionTargetServiceAccount))
+	}
+	targetOneOf := stringvalidator.ExactlyOneOf(targetPaths...)
 
 	attributes["id"] = schema.StringAttribute{
 		Computed: true,
@@ -73,7 +91,7 @@ func (r *resourcePermissionBase) addInSchemaAttributes(attributes map[string]sch
 	}
 	attributes[permissionTargetTeam] = schema.StringAttribute{
 		Optional:    true,
-		Description: "the team onto which the permission is to be assigned",
+		Description: "the team onto which the permission is to be assigned. Either a team ID or a `team:<name>` reference, resolved when applying.",
 		Validators: []validator.String{
 			targetOneOf,
 		},
@@ -83,7 +101,7 @@ func (r *resourcePermissionBase) addInSchemaAttributes(attributes map[string]sch
 	}
 	attributes[permissionTargetUser] = schema.StringAttribute{
 		Optional:    true,
-		Description: "the user or service account onto which the permission is to be assigned",
+		Description: "the user or service account onto which the permission is to be assigned. Service accounts can also be referenced with `sa:<name>`, resolved when applying.",
 		Validators: []validator.String{
 			targetOneOf,
 		},
@@ -91,6 +109,18 @@ func (r *resourcePermissionBase) addInSchemaAttributes(attributes map[string]sch
 			&orgScopedAttributePlanModifier{},
 		},
 	}
+	if r.serviceAccountTarget {
+		attributes[permissionTargetServiceAccount] = schema.StringAttribute{
+			Optional:    true,
+			Description: "the service account onto which the permission is to be assigned. Either a service account ID or a `sa:<name>` reference, resolved when applying.",
+			Validators: []validator.String{
+				targetOneOf,
+			},
+			PlanModifiers: []planmodifier.String{
+				&orgScopedAttributePlanModifier{},
+			},
+		}
+	}
 	attributes["permission"] = schema.StringAttribute{
 		Required:    true,
 		Description: "the permission to be assigned",
@@ -147,6 +177,12 @@ func (r *resourcePermissionBase) readItem(id string, checkExistsFunc func(client
 			} else {
 				continue
 			}
+		case permissionTargetServiceAccount:
+			if v := strconv.FormatInt(permission.UserID, 10); r.serviceAccountTarget && permission.IsServiceAccount && v == permissionTargetID {
+				data.ServiceAccount = types.StringValue(v)
+			} else {
+				continue
+			}
 		case permissionTargetRole:
 			if permission.BuiltInRole == permissionTargetID {
 				data.Role = types.StringValue(permissionTargetID)
@@ -163,6 +199,37 @@ func (r *resourcePermissionBase) readItem(id string, checkExistsFunc func(client
 	return nil, nil
 }
 
+// keepTargetReference keeps the team or service account name reference from the prior state,
+// as long as it still resolves to the target read from the API. Otherwise, the read ID is kept, which shows up as a diff.
+func (r *resourcePermissionBase) keepTargetReference(prior, read *resourcePermissionItemBaseModel) diag.Diagnostics {
+	priorTeam, priorUser, priorServiceAccount := prior.Team.ValueString(), prior.User.ValueString(), prior.ServiceAccount.ValueString()
+	if !strings.HasPrefix(priorTeam, teamReferencePrefix) && !strings.HasPrefix(priorUser, serviceAccountReferencePrefix) && !strings.HasPrefix(priorServiceAccount, serviceAccountReferencePrefix) {
+		return nil
+	}
+
+	client, _, err := r.clientFromNewOrgResource(read.OrgID.ValueString())
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get client", err.Error())}
+	}
+
+	if !read.Team.IsNull() && strings.HasPrefix(priorTeam, teamReferencePrefix) {
+		if teamID, err := resolveTeamReference(client, priorTeam); err == nil && teamID == read.Team.ValueString() {
+			read.Team = prior.Team
+		}
+	}
+	if !read.User.IsNull() && strings.HasPrefix(priorUser, serviceAccountReferencePrefix) {
+		if userID, err := resolveUserReference(client, priorUser); err == nil && userID == read.User.ValueString() {
+			read.User = prior.User
+		}
+	}
+	if !read.ServiceAccount.IsNull() && strings.HasPrefix(priorServiceAccount, serviceAccountReferencePrefix) {
+		if userID, err := resolveUserReference(client, priorServiceAccount); err == nil && userID == read.ServiceAccount.ValueString() {
+			read.ServiceAccount = prior.ServiceAccount
+		}
+	}
+	return nil
+}
+
 func (r *resourcePermissionBase) writeItem(itemID string, data *resourcePermissionItemBaseModel) diag.Diagnostics {
 	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
 	if err != nil {
@@ -178,8 +245,15 @@ func (r *resourcePermissionBase) writeItem(itemID string, data *resourcePermissi
 	data.ResourceID = types.StringValue(itemID)
 
 	switch {
-	case !data.User.IsNull():
-		_, userIDStr := SplitOrgResourceID(data.User.ValueString())
+	case !data.User.IsNull(), !data.ServiceAccount.IsNull():
+		targetType, target := permissionTargetUser, data.User.ValueString()
+		if !data.ServiceAccount.IsNull() {
+			targetType, target = permissionTargetServiceAccount, data.ServiceAccount.ValueString()
+		}
+		userIDStr, resolveErr := resolveUserReference(client, target)
+		if resolveErr != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to resolve "+strings.ReplaceAll(targetType, "_", " "), resolveErr.Error())}
+		}
 		userID, parseErr := strconv.ParseInt(userIDStr, 10, 64)
 		if parseErr != nil {
 			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to parse user ID", parseErr.Error())}
@@ -194,13 +268,16 @@ func (r *resourcePermissionBase) writeItem(itemID string, data *resourcePermissi
 				WithResourceID(itemID),
 		)
 		data.ID = types.StringValue(
-			resourceFolderPermissionItemID.Make(orgID, itemID, permissionTargetUser, userIDStr),
+			resourceFolderPermissionItemID.Make(orgID, itemID, targetType, userIDStr),
 		)
 	case !data.Team.IsNull():
-		_, teamIDStr := SplitOrgResourceID(data.Team.ValueString())
+		teamIDStr, resolveErr := resolveTeamReference(client, data.Team.ValueString())
+		if resolveErr != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to resolve team", resolveErr.Error())}
+		}
 		teamID, parseErr := strconv.ParseInt(teamIDStr, 10, 64)
 		if parseErr != nil {
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to parse user ID", parseErr.Error())}
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to parse team ID", parseErr.Error())}
 		}
 		_, err = client.AccessControl.SetResourcePermissionsForTeam(
 			access_control.NewSetResourcePermissionsForTeamParams().
@@ -233,3 +310,38 @@ func (r *resourcePermissionBase) writeItem(itemID string, data *resourcePermissi
 	}
 	return nil
 }
+
+// resolveTeamReference returns the ID of a team given either its ID (optionally prefixed by the org ID) or a `team:<name>` reference.
+func resolveTeamReference(client *client.GrafanaHTTPAPI, value string) (string, error) {
+	name, ok := strings.CutPrefix(value, teamReferencePrefix)
+	if !ok {
+		_, teamID := SplitOrgResourceID(value)
+		return teamID, nil
+	}
+
+	resp, err := client.Teams.SearchTeams(teams.NewSearchTeamsParams().WithName(&name))
+	if err != nil {
+		return "", err
+	}
+	for _, team := range resp.Payload.Teams {
+		if team.Name == name {
+			return strconv.FormatInt(team.ID, 10), nil
+		}
+	}
+	return "", fmt.Errorf("team %q not found", name)
+}
+
+// resolveUserReference returns the ID of a user or service account given either its ID (optionally prefixed by the org ID) or a `sa:<name>` reference.
+func resolveUserReference(client *client.GrafanaHTTPAPI, value string) (string, error) {
+	name, ok := strings.CutPrefix(value, serviceAccountReferencePrefix)
+	if !ok {
+		_, userID := SplitOrgResourceID(value)
+		return userID, nil
+	}
+
+	sa, err := findServiceAccountByName(client, name)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(sa.ID, 10), nil
+}
@@ -0,0 +1,54 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceMuteTiming() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Data source for retrieving an existing Grafana Alerting mute timing by name.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#mute-timings)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+		ReadContext: datasourceMuteTimingRead,
+		Schema: common.CloneResourceSchemaForDatasource(resourceMuteTiming().Schema, map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the mute timing.",
+			},
+			"disable_provenance": nil,
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_mute_timing", schema)
+}
+
+func datasourceMuteTimingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	name := d.Get("name").(string)
+
+	resp, err := client.Provisioning.GetMuteTiming(name)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return diag.Errorf("no mute timing with name %q", name)
+		}
+		return diag.FromErr(err)
+	}
+	mt := resp.Payload
+
+	d.SetId(MakeOrgResourceID(orgID, mt.Name))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("name", mt.Name)
+	d.Set("intervals", packIntervals(mt.TimeIntervals))
+	return nil
+}
@@ -0,0 +1,36 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceMuteTiming_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var mt models.MuteTimeInterval
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingMuteTimingCheckExists.destroyed(&mt, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_mute_timing/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingMuteTimingCheckExists.exists("grafana_mute_timing.test", &mt),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "name", "Mute Timing Data Source"),
+					resource.TestMatchResourceAttr("data.grafana_mute_timing.from_name", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.0.times.0.start", "04:56"),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.0.times.0.end", "14:17"),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.0.weekdays.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.0.weekdays.1", "tuesday:thursday"),
+					resource.TestCheckResourceAttr("data.grafana_mute_timing.from_name", "intervals.0.location", "America/New_York"),
+				),
+			},
+		},
+	})
+}
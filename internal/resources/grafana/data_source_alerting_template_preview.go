@@ -0,0 +1,206 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dataSourceAlertingTemplatePreviewName = "grafana_alerting_template_preview"
+
+// The alert used to render the template when no alerts are given
+var defaultTemplatePreviewAlert = &models.PostableAlert{
+	Labels: models.LabelSet{
+		"alertname": "TestAlert",
+		"instance":  "Grafana",
+	},
+	Annotations: models.LabelSet{
+		"summary": "Notification test",
+	},
+}
+
+func datasourceAlertingTemplatePreview() *common.DataSource {
+	return common.NewDataSource(
+		common.CategoryAlerting,
+		dataSourceAlertingTemplatePreviewName,
+		&alertingTemplatePreviewDataSource{},
+	)
+}
+
+type alertingTemplatePreviewDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *alertingTemplatePreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceAlertingTemplatePreviewName
+}
+
+func (r *alertingTemplatePreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Renders notification templates against sample alerts, using the Grafana Alertmanager.
+Template errors (invalid syntax or failed execution) are returned as errors, which makes the plan fail
+instead of discovering the issue when alerts fire.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/configure-notifications/template-notifications/)
+
+This data source requires Grafana 10.1.0 or later.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"org_id": pluginFrameworkOrgIDAttribute(),
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the template group, as given to `grafana_message_template`. Defaults to `preview`.",
+			},
+			"template": schema.StringAttribute{
+				Required:    true,
+				Description: "The content of the template group. Each `define` block of the template is rendered.",
+			},
+			"results": schema.ListAttribute{
+				Computed:    true,
+				Description: "The rendered templates, one per `define` block.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+						"text": types.StringType,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alert": schema.ListNestedBlock{
+				Description: "The alerts used to render the templates. If not set, a single sample alert is used.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"labels": schema.MapAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "The labels of the alert.",
+						},
+						"annotations": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The annotations of the alert.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type alertingTemplatePreviewAlertModel struct {
+	Labels      map[string]string `tfsdk:"labels"`
+	Annotations map[string]string `tfsdk:"annotations"`
+}
+
+type alertingTemplatePreviewResultModel struct {
+	Name types.String `tfsdk:"name"`
+	Text types.String `tfsdk:"text"`
+}
+
+type alertingTemplatePreviewDataSourceModel struct {
+	ID       types.String                         `tfsdk:"id"`
+	OrgID    types.String                         `tfsdk:"org_id"`
+	Name     types.String                         `tfsdk:"name"`
+	Template types.String                         `tfsdk:"template"`
+	Alerts   []alertingTemplatePreviewAlertModel  `tfsdk:"alert"`
+	Results  []alertingTemplatePreviewResultModel `tfsdk:"results"`
+}
+
+func (r *alertingTemplatePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data alertingTemplatePreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to create client", err.Error())}
+		return
+	}
+
+	name := data.Name.ValueString()
+	if name == "" {
+		name = "preview"
+	}
+	body := testTemplatesBody{
+		Name:     name,
+		Template: data.Template.ValueString(),
+		Alerts:   []*models.PostableAlert{defaultTemplatePreviewAlert},
+	}
+	if len(data.Alerts) > 0 {
+		body.Alerts = nil
+		for _, alert := range data.Alerts {
+			postable := &models.PostableAlert{
+				Labels:      models.LabelSet(alert.Labels),
+				Annotations: models.LabelSet(alert.Annotations),
+			}
+			body.Alerts = append(body.Alerts, postable)
+		}
+	}
+
+	// Render the templates
+	result, err := testTemplates(ctx, client, body)
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to render templates", err.Error())}
+		return
+	}
+	if len(result.Errors) > 0 {
+		messages := make([]string, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			messages = append(messages, fmt.Sprintf("%s (%s): %s", e.Name, e.Kind, e.Message))
+		}
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Invalid template", strings.Join(messages, "\n"))}
+		return
+	}
+
+	data.Results = []alertingTemplatePreviewResultModel{}
+	for _, res := range result.Results {
+		data.Results = append(data.Results, alertingTemplatePreviewResultModel{
+			Name: types.StringValue(res.Name),
+			Text: types.StringValue(res.Text),
+		})
+	}
+	data.ID = types.StringValue(MakeOrgResourceID(orgID, name))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+type testTemplatesBody struct {
+	Alerts   []*models.PostableAlert `json:"alerts"`
+	Name     string                  `json:"name"`
+	Template string                  `json:"template"`
+}
+
+type testTemplatesResults struct {
+	Results []*models.TestTemplatesResult      `json:"results"`
+	Errors  []*models.TestTemplatesErrorResult `json:"errors"`
+}
+
+// testTemplates calls the Alertmanager template test endpoint.
+func testTemplates(ctx context.Context, client *goapi.GrafanaHTTPAPI, body testTemplatesBody) (*testTemplatesResults, error) {
+	result := &testTemplatesResults{}
+	if err := submitAPIRequest(ctx, client, "TestTemplates", http.MethodPost, "/alertmanager/grafana/config/api/v1/templates/test", body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAlertingTemplatePreview_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.1.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_alerting_template_preview/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.grafana_alerting_template_preview.test", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("data.grafana_alerting_template_preview.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_template_preview.test", "results.0.name", "custom.title"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_template_preview.test", "results.0.text", "1 firing: HighLatency"),
+				),
+			},
+			{
+				Config: `
+data "grafana_alerting_template_preview" "invalid" {
+	template = "{{ define \"custom.title\" }}{{ .Unclosed "
+}`,
+				ExpectError: regexp.MustCompile(`Invalid template`),
+			},
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package grafana
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/grafana-openapi-client-go/client/annotations"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceAnnotations() *common.DataSource {
+	schema := &schema.Resource{
+		ReadContext: readAnnotations,
+		Description: `
+Lists the annotations matching the given filters.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/build-dashboards/annotate-visualizations/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/annotations/#find-annotations)
+`,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return the annotations with these tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"match_any": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Return the annotations with any of the `tags`, instead of all of them.",
+			},
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the annotations of the dashboard with this UID.",
+			},
+			"panel_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return the annotations of the dashboard panel with this ID.",
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The RFC 3339-formatted start of the time range of the annotations.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The RFC 3339-formatted end of the time range of the annotations.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return the annotations of this type. Allowed values: `annotation`, `alert`.",
+				ValidateFunc: validation.StringInSlice([]string{"annotation", "alert"}, false),
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The maximum number of annotations to return.",
+			},
+			"annotations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching annotations, from the most recent to the oldest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The annotation ID.",
+						},
+						"text": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The text of the annotation.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags of the annotation.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"dashboard_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UID of the dashboard of the annotation.",
+						},
+						"panel_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the dashboard panel of the annotation.",
+						},
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC 3339-formatted time of the annotation.",
+						},
+						"time_end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC 3339-formatted end time of the annotation.",
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_annotations", schema)
+}
+
+func readAnnotations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	matchAny := d.Get("match_any").(bool)
+	limit := int64(d.Get("limit").(int))
+	params := annotations.NewGetAnnotationsParams().
+		WithTags(common.SetToStringSlice(d.Get("tags").(*schema.Set))).
+		WithMatchAny(&matchAny).
+		WithLimit(&limit)
+	if v, ok := d.GetOk("dashboard_uid"); ok {
+		dashboardUID := v.(string)
+		params.SetDashboardUID(&dashboardUID)
+	}
+	if v, ok := d.GetOk("panel_id"); ok {
+		panelID := int64(v.(int))
+		params.SetPanelID(&panelID)
+	}
+	if v, ok := d.GetOk("from"); ok {
+		from, err := millisSinceEpoch(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params.SetFrom(&from)
+	}
+	if v, ok := d.GetOk("to"); ok {
+		to, err := millisSinceEpoch(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params.SetTo(&to)
+	}
+	if v, ok := d.GetOk("type"); ok {
+		annotationType := v.(string)
+		params.SetType(&annotationType)
+	}
+
+	resp, err := client.Annotations.GetAnnotations(params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]interface{}, len(resp.Payload))
+	for i, annotation := range resp.Payload {
+		results[i] = map[string]interface{}{
+			"id":            annotation.ID,
+			"text":          annotation.Text,
+			"tags":          annotation.Tags,
+			"dashboard_uid": annotation.DashboardUID,
+			"panel_id":      annotation.PanelID,
+			"time":          time.UnixMilli(annotation.Time).Format(time.RFC3339),
+			"time_end":      time.UnixMilli(annotation.TimeEnd).Format(time.RFC3339),
+		}
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "annotations"))
+	if err := d.Set("annotations", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAnnotations_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0") // Annotations don't work right in OSS Grafana < 9.0.0
+
+	var annotation models.Annotation
+	tag := acctest.RandomWithPrefix("my-service")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             annotationsCheckExists.destroyed(&annotation, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_annotations/data-source.tf", map[string]string{
+					"my-service": tag,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					annotationsCheckExists.exists("grafana_annotation.deployment", &annotation),
+					resource.TestCheckResourceAttr("data.grafana_annotations.deployments", "annotations.#", "1"),
+					resource.TestCheckResourceAttrSet("data.grafana_annotations.deployments", "annotations.0.id"),
+					resource.TestCheckResourceAttr("data.grafana_annotations.deployments", "annotations.0.text", "Deployed version 1.2.3"),
+					resource.TestCheckResourceAttr("data.grafana_annotations.deployments", "annotations.0.time", "2024-01-15T10:00:00Z"),
+					resource.TestCheckResourceAttr("data.grafana_annotations.deployments", "annotations.0.tags.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"slices"
 
 	"github.com/grafana/grafana-openapi-client-go/client/search"
+	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +17,7 @@ func datasourceDashboards() *common.DataSource {
 	schema := &schema.Resource{
 		Description: `
 Datasource for retrieving all dashboards. Specify list of folder IDs to search in for dashboards.
+Dashboards can also be filtered by the data sources they reference or the panel types they contain. These filters require fetching the model of each dashboard matched by the search.
 
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
 * [Folder/Dashboard Search HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/folder_dashboard_search/)
@@ -41,6 +44,18 @@ Datasource for retrieving all dashboards. Specify list of folder IDs to search i
 				Description: "List of string Grafana dashboard tags to search for, eg. `[\"prod\"]`. Used only as search input, i.e., attribute value will remain unchanged.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"datasource_uids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of data source UIDs. Specify to only return dashboards that reference at least one of these data sources (in panels, queries, annotations or template variables). Legacy references by data source name are also matched.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"panel_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of panel types, eg. `[\"timeseries\"]`. Specify to only return dashboards that contain at least one panel of these types (including panels nested in rows).",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"dashboards": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -58,6 +73,10 @@ Datasource for retrieving all dashboards. Specify list of folder IDs to search i
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"folder_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -87,6 +106,17 @@ func dataSourceReadDashboards(ctx context.Context, d *schema.ResourceData, meta
 		id.Write([]byte(fmt.Sprintf("%v", params.Tag)))
 	}
 
+	var datasourceUIDs, panelTypes []string
+	if list, ok := d.GetOk("datasource_uids"); ok {
+		datasourceUIDs = common.ListToStringSlice(list.([]interface{}))
+		id.Write([]byte(fmt.Sprintf("datasources%v", datasourceUIDs)))
+	}
+
+	if list, ok := d.GetOk("panel_types"); ok {
+		panelTypes = common.ListToStringSlice(list.([]interface{}))
+		id.Write([]byte(fmt.Sprintf("panels%v", panelTypes)))
+	}
+
 	d.SetId(MakeOrgResourceID(orgID, id))
 
 	resp, err := client.Search.Search(params)
@@ -94,13 +124,51 @@ func dataSourceReadDashboards(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	dashboards := make([]map[string]interface{}, len(resp.GetPayload()))
-	for i, result := range resp.GetPayload() {
-		dashboards[i] = map[string]interface{}{
+	// Older dashboards reference data sources by name rather than by UID
+	var datasourceNames []string
+	for _, uid := range datasourceUIDs {
+		datasourceResp, err := client.Datasources.GetDataSourceByUID(uid)
+		if common.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return diag.Errorf("error getting data source %s: %s", uid, err)
+		}
+		datasourceNames = append(datasourceNames, datasourceResp.Payload.Name)
+	}
+
+	// Filtering on the content of the dashboards requires fetching each of them
+	results := resp.GetPayload()
+	matches := make([]bool, len(results))
+	if err := common.ForEachParallel(ctx, results, func(ctx context.Context, i int, result *models.Hit) error {
+		if len(datasourceUIDs) == 0 && len(panelTypes) == 0 {
+			matches[i] = true
+			return nil
+		}
+		dashboardResp, err := client.Dashboards.GetDashboardByUID(result.UID)
+		if err != nil {
+			return fmt.Errorf("error getting dashboard %s: %w", result.UID, err)
+		}
+		model, _ := dashboardResp.Payload.Dashboard.(map[string]interface{})
+		matches[i] = (len(datasourceUIDs) == 0 || dashboardUsesDatasource(model, datasourceUIDs, datasourceNames)) &&
+			(len(panelTypes) == 0 || dashboardHasPanelType(model["panels"], panelTypes))
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboards := []map[string]interface{}{}
+	for i, result := range results {
+		if !matches[i] {
+			continue
+		}
+
+		dashboards = append(dashboards, map[string]interface{}{
 			"title":        result.Title,
 			"uid":          result.UID,
 			"folder_title": result.FolderTitle,
-		}
+			"folder_uid":   result.FolderUID,
+		})
 	}
 
 	if err := d.Set("dashboards", dashboards); err != nil {
@@ -109,3 +177,53 @@ func dataSourceReadDashboards(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+// dashboardUsesDatasource walks the dashboard model and returns true if any `datasource` reference matches one of the given data sources.
+// References can either be objects (`{"type": "...", "uid": "..."}`) or, in older dashboards, plain strings holding the UID or the name of the data source.
+func dashboardUsesDatasource(model interface{}, datasourceUIDs, datasourceNames []string) bool {
+	switch v := model.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "datasource" {
+				switch ref := child.(type) {
+				case string:
+					if slices.Contains(datasourceUIDs, ref) || slices.Contains(datasourceNames, ref) {
+						return true
+					}
+				case map[string]interface{}:
+					if uid, _ := ref["uid"].(string); uid != "" && slices.Contains(datasourceUIDs, uid) {
+						return true
+					}
+				}
+			}
+			if dashboardUsesDatasource(child, datasourceUIDs, datasourceNames) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if dashboardUsesDatasource(child, datasourceUIDs, datasourceNames) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dashboardHasPanelType returns true if any of the panels (or panels nested in rows) is of one of the given types.
+func dashboardHasPanelType(panels interface{}, panelTypes []string) bool {
+	panelList, _ := panels.([]interface{})
+	for _, panel := range panelList {
+		panelMap, ok := panel.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if panelType, ok := panelMap["type"].(string); ok && slices.Contains(panelTypes, panelType) {
+			return true
+		}
+		if dashboardHasPanelType(panelMap["panels"], panelTypes) {
+			return true
+		}
+	}
+	return false
+}
@@ -32,6 +32,13 @@ func TestAccDataSourceDashboardsAllAndByFolderUID(t *testing.T) {
 					resource.TestCheckResourceAttr("data.grafana_dashboards.folder_uids_tags", "dashboards.0.title", "data_source_dashboards 1"),
 					resource.TestCheckResourceAttr("data.grafana_dashboards.folder_uids_tags", "dashboards.0.folder_title", "test folder data_source_dashboards"),
 
+					resource.TestCheckResourceAttr("data.grafana_dashboards.datasource_uids", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_dashboards.datasource_uids", "dashboards.0.uid", "data-source-dashboards-1"),
+					resource.TestCheckResourceAttrPair("data.grafana_dashboards.datasource_uids", "dashboards.0.folder_uid", "grafana_folder.data_source_dashboards", "uid"),
+
+					resource.TestCheckResourceAttr("data.grafana_dashboards.panel_types", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_dashboards.panel_types", "dashboards.0.uid", "data-source-dashboards-1"),
+
 					resource.TestCheckResourceAttr("data.grafana_dashboards.limit_one", "dashboards.#", "1"),
 					resource.TestCheckResourceAttr("data.grafana_dashboards.limit_one", "dashboards.0.uid", "data-source-dashboards-1"),
 					resource.TestCheckResourceAttr("data.grafana_dashboards.limit_one", "dashboards.0.title", "data_source_dashboards 1"),
@@ -51,3 +58,70 @@ func TestAccDataSourceDashboardsAllAndByFolderUID(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceDashboards_legacyDatasourceReference(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "grafana_organization" "test" {
+  name = "testing dashboards data source legacy references"
+}
+
+resource "grafana_data_source" "test" {
+  org_id = grafana_organization.test.id
+  type   = "prometheus"
+  name   = "legacy-reference-prometheus"
+  url    = "http://localhost:9090"
+}
+
+resource "grafana_dashboard" "by_name" {
+  org_id = grafana_organization.test.id
+  config_json = jsonencode({
+    uid   = "legacy-reference-by-name"
+    title = "legacy reference by name"
+    panels = [
+      {
+        id         = 1
+        type       = "timeseries"
+        datasource = grafana_data_source.test.name
+      }
+    ]
+  })
+}
+
+resource "grafana_dashboard" "other" {
+  org_id = grafana_organization.test.id
+  config_json = jsonencode({
+    uid   = "legacy-reference-other"
+    title = "legacy reference other"
+    panels = [
+      {
+        id         = 1
+        type       = "timeseries"
+        datasource = "other-prometheus"
+      }
+    ]
+  })
+}
+
+data "grafana_dashboards" "test" {
+  org_id          = grafana_organization.test.id
+  datasource_uids = [grafana_data_source.test.uid]
+  depends_on = [
+    grafana_dashboard.by_name,
+    grafana_dashboard.other
+  ]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_dashboards.test", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_dashboards.test", "dashboards.0.uid", "legacy-reference-by-name"),
+				),
+			},
+		},
+	})
+}
@@ -27,8 +27,16 @@ func datasourceDatasource() *common.DataSource {
 				Computed:     true,
 				AtLeastOneOf: []string{"name", "uid"},
 			},
-			"secure_json_data_encoded": nil,
-			"http_headers":             nil,
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the data source. Grafana increments it on each change of the data source.",
+			},
+			"secure_json_data_encoded":         nil,
+			"secure_json_data_drift_detection": nil,
+			"validate":                         nil,
+			"validate_warn_only":               nil,
+			"http_headers":                     nil,
 		}),
 	}
 	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_data_source", schema)
@@ -56,5 +64,6 @@ func datasourceDatasourceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unexpected state, API response is nil")
 	}
 
+	d.Set("version", resp.GetPayload().Version)
 	return datasourceToState(d, resp.GetPayload())
 }
@@ -0,0 +1,134 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceDatasources() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the data sources of an organization, optionally filtered by type and name.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/#get-all-data-sources)
+`,
+		ReadContext: readDatasources,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the data sources of the given type (eg. `prometheus`).",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the data sources whose name matches the given glob pattern (eg. `prod-*`). `*` matches any sequence of characters, including `/`.",
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := path.Match(i.(string), ""); err != nil {
+						return nil, []error{fmt.Errorf("%q is not a valid glob pattern: %w", k, err)}
+					}
+					return nil, nil
+				},
+			},
+			"data_sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data sources matching the filters, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The numerical ID of the data source.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the data source.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the data source.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the data source.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the data source.",
+						},
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the data source is the default data source of the organization.",
+						},
+						"read_only": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the data source is read-only (eg. provisioned, or managed by Grafana Cloud).",
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_data_sources", schema)
+}
+
+func readDatasources(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	resp, err := client.Datasources.GetDataSources()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dsType := d.Get("type").(string)
+	namePattern := d.Get("name").(string)
+	dataSources := make([]interface{}, 0, len(resp.Payload))
+	for _, ds := range resp.Payload {
+		if dsType != "" && ds.Type != dsType {
+			continue
+		}
+		if namePattern != "" {
+			if !matchDataSourceName(namePattern, ds.Name) {
+				continue
+			}
+		}
+		dataSources = append(dataSources, map[string]interface{}{
+			"id":         ds.ID,
+			"uid":        ds.UID,
+			"name":       ds.Name,
+			"type":       ds.Type,
+			"url":        ds.URL,
+			"is_default": ds.IsDefault,
+			"read_only":  ds.ReadOnly,
+		})
+	}
+	sort.Slice(dataSources, func(i, j int) bool {
+		return dataSources[i].(map[string]interface{})["name"].(string) < dataSources[j].(map[string]interface{})["name"].(string)
+	})
+
+	d.SetId(MakeOrgResourceID(orgID, "data_sources"))
+	return diag.FromErr(d.Set("data_sources", dataSources))
+}
+
+// matchDataSourceName matches the name against the glob pattern. Unlike file paths, data source names have no separator,
+// so `/` is replaced by a character that path.Match doesn't treat specially, letting `*` and `?` match it.
+func matchDataSourceName(pattern, name string) bool {
+	match, _ := path.Match(strings.ReplaceAll(pattern, "/", "\x00"), strings.ReplaceAll(name, "/", "\x00"))
+	return match
+}
@@ -0,0 +1,85 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDatasources_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dsA, dsB models.DataSource
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_data_sources/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.prometheus_a", &dsA),
+					datasourceCheckExists.exists("grafana_data_source.prometheus_b", &dsB),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.#", "2"),
+					resource.TestCheckResourceAttrPair("data.grafana_data_sources.prometheus", "data_sources.0.uid", "grafana_data_source.prometheus_a", "uid"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.name", "prometheus-ds-a"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.type", "prometheus"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.url", "http://prometheus-a.example.net:9090"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.is_default", "false"),
+					resource.TestCheckResourceAttrPair("data.grafana_data_sources.prometheus", "data_sources.1.uid", "grafana_data_source.prometheus_b", "uid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceDatasources_nameWithSlash(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	orgName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_organization" "test" {
+  name = "%s"
+}
+
+resource "grafana_data_source" "team" {
+  org_id = grafana_organization.test.id
+  type   = "prometheus"
+  name   = "team-a/prometheus"
+  url    = "http://prometheus-a.example.net:9090"
+}
+
+resource "grafana_data_source" "other" {
+  org_id = grafana_organization.test.id
+  type   = "prometheus"
+  name   = "team-b-prometheus"
+  url    = "http://prometheus-b.example.net:9090"
+}
+
+data "grafana_data_sources" "test" {
+  org_id = grafana_organization.test.id
+  name   = "team-a*"
+
+  depends_on = [
+    grafana_data_source.team,
+    grafana_data_source.other,
+  ]
+}
+`, orgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_data_sources.test", "data_sources.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.test", "data_sources.0.name", "team-a/prometheus"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dataSourceFolderTreeName = "grafana_folder_tree"
+
+func datasourceFolderTree() *common.DataSource {
+	return common.NewDataSource(
+		common.CategoryGrafanaOSS,
+		dataSourceFolderTreeName,
+		&folderTreeDataSource{},
+	)
+}
+
+type folderTreeDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *folderTreeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceFolderTreeName
+}
+
+func (r *folderTreeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Data source for retrieving the hierarchy of nested folders, either from the root or from a given folder.
+Folders are returned in depth-first order, each folder being followed by its subfolders.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/manage-dashboards/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/folder/)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"org_id": pluginFrameworkOrgIDAttribute(),
+			"root_folder_uid": schema.StringAttribute{
+				Optional:    true,
+				Description: "The UID of the folder from which to list subfolders. The folder itself is not included. If not set, the whole hierarchy is returned.",
+			},
+			"max_depth": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum depth of folders to return. Folders directly under the root have a depth of 0. If not set, all levels are returned.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"folders": schema.ListAttribute{
+				Computed:    true,
+				Description: "The folders of the hierarchy. `parent_uids` lists all the ancestors of each folder, including those of the root folder, from the furthest to the closest.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"uid":         types.StringType,
+						"title":       types.StringType,
+						"parent_uid":  types.StringType,
+						"parent_uids": types.ListType{ElemType: types.StringType},
+						"depth":       types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+type folderTreeDataSourceFolderModel struct {
+	UID        types.String   `tfsdk:"uid"`
+	Title      types.String   `tfsdk:"title"`
+	ParentUID  types.String   `tfsdk:"parent_uid"`
+	ParentUIDs []types.String `tfsdk:"parent_uids"`
+	Depth      types.Int64    `tfsdk:"depth"`
+}
+
+type folderTreeDataSourceModel struct {
+	ID            types.String                      `tfsdk:"id"`
+	OrgID         types.String                      `tfsdk:"org_id"`
+	RootFolderUID types.String                      `tfsdk:"root_folder_uid"`
+	MaxDepth      types.Int64                       `tfsdk:"max_depth"`
+	Folders       []folderTreeDataSourceFolderModel `tfsdk:"folders"`
+}
+
+func (r *folderTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data folderTreeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read from API
+	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to create client", err.Error())}
+		return
+	}
+
+	rootUID := data.RootFolderUID.ValueString()
+	maxDepth := int64(-1)
+	if !data.MaxDepth.IsNull() {
+		maxDepth = data.MaxDepth.ValueInt64()
+	}
+
+	data.Folders = []folderTreeDataSourceFolderModel{}
+	var walk func(parentUIDs []string, depth int64) error
+	walk = func(parentUIDs []string, depth int64) error {
+		parentUID := rootUID
+		if len(parentUIDs) > 0 {
+			parentUID = parentUIDs[len(parentUIDs)-1]
+		}
+		children, err := listSubfolders(client, parentUID)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			folder := folderTreeDataSourceFolderModel{
+				UID:        types.StringValue(child.UID),
+				Title:      types.StringValue(child.Title),
+				ParentUID:  types.StringValue(parentUID),
+				ParentUIDs: []types.String{},
+				Depth:      types.Int64Value(depth),
+			}
+			for _, uid := range parentUIDs {
+				folder.ParentUIDs = append(folder.ParentUIDs, types.StringValue(uid))
+			}
+			data.Folders = append(data.Folders, folder)
+
+			if maxDepth < 0 || depth < maxDepth {
+				if err := walk(append(parentUIDs[:len(parentUIDs):len(parentUIDs)], child.UID), depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	rootParentUIDs := []string{}
+	if rootUID != "" {
+		// The ancestors of the root folder are also ancestors of its subfolders
+		root, err := GetFolderByIDorUID(client.Folders, rootUID)
+		if err != nil {
+			resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get root folder", err.Error())}
+			return
+		}
+		for _, ancestor := range root.Parents {
+			rootParentUIDs = append(rootParentUIDs, ancestor.UID)
+		}
+		rootParentUIDs = append(rootParentUIDs, rootUID)
+	}
+	if err := walk(rootParentUIDs, 0); err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to list folders", err.Error())}
+		return
+	}
+
+	data.ID = types.StringValue(MakeOrgResourceID(orgID, rootUID))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// listSubfolders lists the direct subfolders of a folder, or the top level folders if the parent UID is empty.
+func listSubfolders(client *goapi.GrafanaHTTPAPI, parentUID string) ([]*models.FolderSearchHit, error) {
+	var result []*models.FolderSearchHit
+	var page int64 = 1
+	var limit int64 = 1000
+	for {
+		params := folders.NewGetFoldersParams().WithPage(&page).WithLimit(&limit)
+		if parentUID != "" {
+			params.SetParentUID(&parentUID)
+		}
+		resp, err := client.Folders.GetFolders(params)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resp.Payload...)
+		if int64(len(resp.Payload)) < limit {
+			return result, nil
+		}
+		page++
+	}
+}
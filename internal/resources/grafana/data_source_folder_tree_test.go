@@ -0,0 +1,45 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceFolderTree_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_folder_tree/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.0.uid", "folder-tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.0.title", "folder-tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.0.parent_uid", "folder-tree-parent"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.0.parent_uids.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.uid", "folder-tree-grandchild"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.parent_uid", "folder-tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.depth", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.parent_uids.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.parent_uids.0", "folder-tree-parent"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.subtree", "folders.1.parent_uids.1", "folder-tree-child"),
+
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.direct_children", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.direct_children", "folders.0.uid", "folder-tree-child"),
+
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.0.uid", "folder-tree-grandchild"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.0.parent_uids.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.0.parent_uids.0", "folder-tree-parent"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.nested", "folders.0.parent_uids.1", "folder-tree-child"),
+				),
+			},
+		},
+	})
+}
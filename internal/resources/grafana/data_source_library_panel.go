@@ -24,6 +24,7 @@ func datasourceLibraryPanel() *common.DataSource {
 				Optional:    true,
 				Description: "The unique identifier (UID) of the library panel.",
 			},
+			"ignore_json_paths": nil,
 		}),
 	}
 	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_library_panel", schema)
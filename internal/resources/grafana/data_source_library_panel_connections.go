@@ -0,0 +1,135 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/grafana/grafana-openapi-client-go/client/search"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dataSourceLibraryPanelConnectionsName = "grafana_library_panel_connections"
+
+func datasourceLibraryPanelConnections() *common.DataSource {
+	return common.NewDataSource(
+		common.CategoryGrafanaOSS,
+		dataSourceLibraryPanelConnectionsName,
+		&libraryPanelConnectionsDataSource{},
+	)
+}
+
+type libraryPanelConnectionsDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *libraryPanelConnectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceLibraryPanelConnectionsName
+}
+
+func (r *libraryPanelConnectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Data source for retrieving the dashboards connected to a library panel.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/build-dashboards/manage-library-panels/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/library_element/#get-library-element-connections)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"org_id": pluginFrameworkOrgIDAttribute(),
+			"library_panel_uid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UID of the library panel.",
+			},
+			"dashboards": schema.ListAttribute{
+				Computed:    true,
+				Description: "The dashboards connected to the library panel, sorted by UID.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":         types.Int64Type,
+						"uid":        types.StringType,
+						"title":      types.StringType,
+						"folder_uid": types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type libraryPanelConnectionsDataSourceDashboardModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	UID       types.String `tfsdk:"uid"`
+	Title     types.String `tfsdk:"title"`
+	FolderUID types.String `tfsdk:"folder_uid"`
+}
+
+type libraryPanelConnectionsDataSourceModel struct {
+	ID              types.String                                      `tfsdk:"id"`
+	OrgID           types.String                                      `tfsdk:"org_id"`
+	LibraryPanelUID types.String                                      `tfsdk:"library_panel_uid"`
+	Dashboards      []libraryPanelConnectionsDataSourceDashboardModel `tfsdk:"dashboards"`
+}
+
+func (r *libraryPanelConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data libraryPanelConnectionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read from API
+	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to create client", err.Error())}
+		return
+	}
+	panelUID := data.LibraryPanelUID.ValueString()
+	connResp, err := client.LibraryElements.GetLibraryElementConnections(panelUID)
+	if err != nil {
+		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get library panel connections", err.Error())}
+		return
+	}
+
+	// The connections only contain the dashboard UIDs, the search API is used to get the rest of the dashboard info
+	dashboardUIDs := []string{}
+	for _, connection := range connResp.Payload.Result {
+		dashboardUIDs = append(dashboardUIDs, connection.ConnectionUID)
+	}
+	data.Dashboards = []libraryPanelConnectionsDataSourceDashboardModel{}
+	if len(dashboardUIDs) > 0 {
+		searchType := "dash-db"
+		params := search.NewSearchParams().WithType(&searchType).WithDashboardUIDs(dashboardUIDs)
+		searchResp, err := client.Search.Search(params)
+		if err != nil {
+			resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get connected dashboards", err.Error())}
+			return
+		}
+		for _, hit := range searchResp.Payload {
+			data.Dashboards = append(data.Dashboards, libraryPanelConnectionsDataSourceDashboardModel{
+				ID:        types.Int64Value(hit.ID),
+				UID:       types.StringValue(hit.UID),
+				Title:     types.StringValue(hit.Title),
+				FolderUID: types.StringValue(hit.FolderUID),
+			})
+		}
+		sort.Slice(data.Dashboards, func(i, j int) bool {
+			return data.Dashboards[i].UID.ValueString() < data.Dashboards[j].UID.ValueString()
+		})
+	}
+
+	data.ID = types.StringValue(MakeOrgResourceID(orgID, panelUID))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,33 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceLibraryPanelConnections_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	randomName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_library_panel_connections/data-source.tf", map[string]string{
+					"panelname": randomName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.grafana_library_panel_connections.test", "library_panel_uid", "grafana_library_panel.test", "uid"),
+					resource.TestCheckResourceAttr("data.grafana_library_panel_connections.test", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_library_panel_connections.test", "dashboards.0.uid", randomName+"-dashboard"),
+					resource.TestCheckResourceAttr("data.grafana_library_panel_connections.test", "dashboards.0.title", randomName+" Dashboard"),
+					resource.TestCheckResourceAttrSet("data.grafana_library_panel_connections.test", "dashboards.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/grafana/grafana-openapi-client-go/client/library_elements"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -79,13 +78,12 @@ func (r *libraryPanelsDataSource) Read(ctx context.Context, req datasource.ReadR
 		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to create client", err.Error())}
 		return
 	}
-	params := library_elements.NewGetLibraryElementsParams().WithKind(common.Ref(libraryPanelKind))
-	apiResp, err := client.LibraryElements.GetLibraryElements(params)
+	panels, err := getAllLibraryPanels(client)
 	if err != nil {
 		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get library panels", err.Error())}
 		return
 	}
-	for _, panel := range apiResp.Payload.Result.Elements {
+	for _, panel := range panels {
 		modelJSONBytes, err := json.Marshal(panel.Model)
 		if err != nil {
 			resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get library panel JSON", err.Error())}
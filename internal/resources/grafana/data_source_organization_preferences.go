@@ -33,6 +33,12 @@ func dataSourceOrganizationPreferencesRead(ctx context.Context, d *schema.Resour
 	prefs := resp.Payload
 	d.Set("theme", prefs.Theme)
 	d.Set("home_dashboard_uid", prefs.HomeDashboardUID)
+	d.Set("language", prefs.Language)
+	var bookmarkIDs []string
+	if prefs.Navbar != nil {
+		bookmarkIDs = prefs.Navbar.BookmarkIds
+	}
+	d.Set("navbar_bookmark_ids", bookmarkIDs)
 	d.Set("timezone", prefs.Timezone)
 	d.Set("week_start", prefs.WeekStart)
 
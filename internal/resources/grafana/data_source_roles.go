@@ -0,0 +1,173 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceRoles() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the fixed and custom roles of an organization, with their permissions.
+
+**Note:** This data source is available only with Grafana Enterprise 8.+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
+`,
+		ReadContext: dataSourceRolesRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the roles whose name starts with the given prefix (eg. `fixed:` for the fixed roles, or `fixed:dashboards:`).",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the roles of the given group (eg. `Dashboards`).",
+			},
+			"include_hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to return the hidden roles.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The roles matching the filters, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of the role. Used for assignments.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the role.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the role.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the role.",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group of the role.",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Version of the role.",
+						},
+						"global": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the role is global or scoped to the organization.",
+						},
+						"hidden": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the role is hidden in the Grafana UI.",
+						},
+						"permissions": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "The permissions of the role.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Specific action users granted with the role will be allowed to perform (for example: `users:read`)",
+									},
+									"scope": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Scope to restrict the action to a set of resources (for example: `users:*` or `roles:customrole1`)",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaEnterprise, "grafana_roles", schema)
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	params := access_control.NewListRolesParams().WithIncludeHidden(common.Ref(d.Get("include_hidden").(bool)))
+	resp, err := client.AccessControl.ListRoles(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	group := d.Get("group").(string)
+	var listedRoles []*models.RoleDTO
+	for _, listed := range resp.Payload {
+		if !strings.HasPrefix(listed.Name, namePrefix) || (group != "" && listed.Group != group) {
+			continue
+		}
+		listedRoles = append(listedRoles, listed)
+	}
+
+	// The permissions are not included in the list, so each role is fetched
+	roles := make([]interface{}, len(listedRoles))
+	if err := common.ForEachParallel(ctx, listedRoles, func(ctx context.Context, i int, listed *models.RoleDTO) error {
+		roleResp, err := client.AccessControl.GetRole(listed.UID)
+		if err != nil {
+			return fmt.Errorf("error getting role %s: %w", listed.UID, err)
+		}
+		r := roleResp.Payload
+
+		perms := make([]interface{}, 0, len(r.Permissions))
+		for _, p := range r.Permissions {
+			perms = append(perms, map[string]interface{}{
+				"action": p.Action,
+				"scope":  p.Scope,
+			})
+		}
+		roles[i] = map[string]interface{}{
+			"uid":          r.UID,
+			"name":         r.Name,
+			"display_name": r.DisplayName,
+			"description":  r.Description,
+			"group":        r.Group,
+			"version":      r.Version,
+			"global":       r.Global,
+			"hidden":       r.Hidden,
+			"permissions":  perms,
+		}
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].(map[string]interface{})["name"].(string) < roles[j].(map[string]interface{})["name"].(string)
+	})
+
+	d.SetId(MakeOrgResourceID(orgID, "roles"))
+	return diag.FromErr(d.Set("roles", roles))
+}
@@ -0,0 +1,35 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRoles_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	var role models.RoleDTO
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             roleCheckExists.destroyed(&role, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_roles/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					roleCheckExists.exists("grafana_role.test", &role),
+					resource.TestCheckResourceAttr("data.grafana_roles.custom", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_roles.custom", "roles.0.uid", "test-roles-ds-uid"),
+					resource.TestCheckResourceAttr("data.grafana_roles.custom", "roles.0.group", "Test Roles"),
+					resource.TestCheckResourceAttr("data.grafana_roles.custom", "roles.0.permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_roles.custom", "roles.0.permissions.0.action", "org.users:read"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_roles.fixed_dashboards", "roles.*", map[string]string{
+						"name": "fixed:dashboards:reader",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -43,7 +43,7 @@ func datasourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func findServiceAccountByName(client *client.GrafanaHTTPAPI, name string) (*models.ServiceAccountDTO, error) {
-	var page int64 = 0
+	var page int64 = 1
 	for {
 		params := service_accounts.NewSearchOrgServiceAccountsWithPagingParams().WithPage(&page)
 		resp, err := client.ServiceAccounts.SearchOrgServiceAccountsWithPaging(params)
@@ -59,6 +59,7 @@ func findServiceAccountByName(client *client.GrafanaHTTPAPI, name string) (*mode
 				return sa, nil
 			}
 		}
+		page++
 	}
 	return nil, fmt.Errorf("service account %q not found", name)
 }
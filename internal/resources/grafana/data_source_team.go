@@ -48,7 +48,7 @@ func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	for _, r := range searchTeam.Teams {
 		if r.Name == name {
-			return readTeamFromID(client, r.ID, d, d.Get("read_team_sync").(bool))
+			return readTeamFromID(client, r.ID, d, d.Get("read_team_sync").(bool), true)
 		}
 	}
 
@@ -2,6 +2,10 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/users"
@@ -9,8 +13,11 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const usersSearchPageSize = 1000
+
 func datasourceUsers() *common.DataSource {
 	schema := &schema.Resource{
 		ReadContext: readUsers,
@@ -27,10 +34,22 @@ does not currently work with API Tokens. You must use basic auth.
 		`,
 
 		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"query": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the users whose login, email or name contains the given string.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return the users with the given role (`Viewer`, `Editor`, `Admin` or `None`) in the organization set by `org_id`.",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
+			},
 			"users": {
 				Type:        schema.TypeSet,
 				Computed:    true,
-				Description: "The Grafana instance's users.",
+				Description: "The Grafana instance's users, matching the filters.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -71,12 +90,36 @@ func readUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	allUsers, err := getAllUsers(client)
+	query := d.Get("query").(string)
+	matchedUsers, err := searchUsers(ctx, client, query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	// Roles are per organization, so they are only returned by the org users search
+	if role := d.Get("role").(string); role != "" {
+		_, orgID := OAPIClientFromNewOrgResource(meta, d)
+		orgUsers, err := searchOrgUsers(ctx, client, orgID, query)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		withRole := map[int64]bool{}
+		for _, orgUser := range orgUsers {
+			if orgUser.Role == role {
+				withRole[orgUser.UserID] = true
+			}
+		}
+		var filteredUsers []*models.UserSearchHitDTO
+		for _, user := range matchedUsers {
+			if withRole[user.ID] {
+				filteredUsers = append(filteredUsers, user)
+			}
+		}
+		matchedUsers = filteredUsers
+	}
+
 	d.SetId("grafana_users")
-	return diag.FromErr(d.Set("users", flattenUsers(allUsers)))
+	return diag.FromErr(d.Set("users", flattenUsers(matchedUsers)))
 }
 
 func flattenUsers(items []*models.UserSearchHitDTO) []interface{} {
@@ -113,3 +156,44 @@ func getAllUsers(client *goapi.GrafanaHTTPAPI) ([]*models.UserSearchHitDTO, erro
 	}
 	return allUsers, nil
 }
+
+// searchUsers returns the users whose login, email or name contains the query, going through all pages of the search.
+func searchUsers(ctx context.Context, client *goapi.GrafanaHTTPAPI, query string) ([]*models.UserSearchHitDTO, error) {
+	var matchedUsers []*models.UserSearchHitDTO
+	for page := 1; ; page++ {
+		var result models.SearchUserQueryResult
+		if err := submitAPIRequestWithQuery(ctx, client, "SearchUsersWithPaging", http.MethodGet, "/users/search", usersSearchQuery(query, page), nil, &result); err != nil {
+			return nil, err
+		}
+		matchedUsers = append(matchedUsers, result.Users...)
+		if len(result.Users) < usersSearchPageSize || int64(len(matchedUsers)) >= result.TotalCount {
+			return matchedUsers, nil
+		}
+	}
+}
+
+// searchOrgUsers returns the users of an organization whose login, email or name contains the query, going through all pages of the search.
+func searchOrgUsers(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, query string) ([]*models.OrgUserDTO, error) {
+	var matchedUsers []*models.OrgUserDTO
+	for page := 1; ; page++ {
+		var result models.SearchOrgUsersQueryResult
+		if err := submitAPIRequestWithQuery(ctx, client, "SearchOrgUsers", http.MethodGet, fmt.Sprintf("/orgs/%d/users/search", orgID), usersSearchQuery(query, page), nil, &result); err != nil {
+			return nil, err
+		}
+		matchedUsers = append(matchedUsers, result.OrgUsers...)
+		if len(result.OrgUsers) < usersSearchPageSize || int64(len(matchedUsers)) >= result.TotalCount {
+			return matchedUsers, nil
+		}
+	}
+}
+
+func usersSearchQuery(query string, page int) url.Values {
+	values := url.Values{
+		"page":    []string{strconv.Itoa(page)},
+		"perpage": []string{strconv.Itoa(usersSearchPageSize)},
+	}
+	if query != "" {
+		values.Set("query", query)
+	}
+	return values
+}
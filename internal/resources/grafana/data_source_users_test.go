@@ -22,6 +22,11 @@ func TestAccDatasourceUsers_basic(t *testing.T) {
 				"login": "test-grafana-users",
 				"email": "all_users@example.com",
 			}),
+		resource.TestCheckResourceAttr("data.grafana_users.filtered_users", "users.#", "1"),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"data.grafana_users.filtered_users", "users.*", map[string]string{
+				"login": "test-grafana-users",
+			}),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1,6 +1,7 @@
 package grafana
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -105,3 +106,19 @@ func parseOrgID(d *schema.ResourceData) int64 {
 	orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64)
 	return orgID
 }
+
+// importOrgResourceByName wraps the importer of an org-scoped resource so that it also accepts `[<orgID>:]name:<name>` import IDs.
+// The name is resolved to the ID of the resource (without the org ID) by the given function.
+func importOrgResourceByName(resolve func(client *goapi.GrafanaHTTPAPI, name string) (string, error), next schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		client, orgID, restOfID := OAPIClientFromExistingOrgResource(meta, d.Id())
+		if name, ok := common.ImportName(restOfID); ok {
+			id, err := resolve(client, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve the import ID %q: %w", d.Id(), err)
+			}
+			d.SetId(MakeOrgResourceID(orgID, id))
+		}
+		return next(ctx, d, meta)
+	}
+}
@@ -0,0 +1,121 @@
+package grafana
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+const alertingAdminConfigSingletonID = "admin_config"
+
+func resourceAlertingAdminConfig() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the alerting admin configuration of an organization, which sets the Alertmanagers that receive the Grafana-managed alerts.
+
+External Alertmanagers are data sources of the ` + "`alertmanager`" + ` type. To send alerts to one of them, set ` + "`handleGrafanaManagedAlerts = true`" + ` in the ` + "`json_data_encoded`" + ` of its ` + "`grafana_data_source`" + ` resource.
+Deleting this resource resets the configuration to its default (alerts are sent to all Alertmanagers).
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/configure-alertmanager/)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+
+		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](putAlertingAdminConfig),
+		ReadContext:   readAlertingAdminConfig,
+		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](putAlertingAdminConfig),
+		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteAlertingAdminConfig),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"alertmanagers_choice": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Alertmanagers that receive the Grafana-managed alerts. Options are `internal` (the Grafana Alertmanager), `external` (the external Alertmanager data sources) and `all`.",
+				ValidateFunc: validation.StringInSlice([]string{"all", "internal", "external"}, false),
+			},
+			"external_alertmanager_datasource_uids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The UIDs of the Alertmanager data sources that are configured to receive the Grafana-managed alerts.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_alerting_admin_config",
+		orgResourceIDString("anyString"),
+		schema,
+	)
+}
+
+type alertingAdminConfig struct {
+	AlertmanagersChoice string `json:"alertmanagersChoice"`
+}
+
+func readAlertingAdminConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	var config alertingAdminConfig
+	err := submitAPIRequest(ctx, client, "GetNGalertConfig", http.MethodGet, "/v1/ngalert/admin_config", nil, &config)
+	if err, shouldReturn := common.CheckReadError("alerting admin config", data, err); shouldReturn {
+		return err
+	}
+
+	datasources, err := client.Datasources.GetDataSources()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var externalUIDs []string
+	for _, ds := range datasources.Payload {
+		if ds.Type != "alertmanager" {
+			continue
+		}
+		if jsonData, ok := ds.JSONData.(map[string]interface{}); ok && jsonData["handleGrafanaManagedAlerts"] == true {
+			externalUIDs = append(externalUIDs, ds.UID)
+		}
+	}
+
+	data.Set("alertmanagers_choice", config.AlertmanagersChoice)
+	data.Set("external_alertmanager_datasource_uids", externalUIDs)
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.SetId(MakeOrgResourceID(orgID, alertingAdminConfigSingletonID))
+
+	return nil
+}
+
+func putAlertingAdminConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+
+	config := alertingAdminConfig{
+		AlertmanagersChoice: data.Get("alertmanagers_choice").(string),
+	}
+	if err := submitAPIRequest(ctx, client, "PostNGalertConfig", http.MethodPost, "/v1/ngalert/admin_config", config, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, alertingAdminConfigSingletonID))
+	return readAlertingAdminConfig(ctx, data, meta)
+}
+
+func deleteAlertingAdminConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	err := submitAPIRequest(ctx, client, "DeleteNGalertConfig", http.MethodDelete, "/v1/ngalert/admin_config", nil, nil)
+	diag, _ := common.CheckReadError("alerting admin config", data, err)
+	return diag
+}
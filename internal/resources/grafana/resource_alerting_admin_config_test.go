@@ -0,0 +1,40 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccAlertingAdminConfig_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	// The admin config is a singleton of the default org
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_alerting_admin_config/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_alerting_admin_config.config", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "alertmanagers_choice", "external"),
+					resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "external_alertmanager_datasource_uids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("grafana_alerting_admin_config.config", "external_alertmanager_datasource_uids.*", "grafana_data_source.external_alertmanager", "uid"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_alerting_admin_config/resource.tf", map[string]string{
+					`"external"`: `"all"`,
+				}),
+				Check: resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "alertmanagers_choice", "all"),
+			},
+			{
+				ResourceName:      "grafana_alerting_admin_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
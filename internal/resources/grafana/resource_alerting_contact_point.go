@@ -28,6 +28,7 @@ var (
 		googleChatNotifier{},
 		kafkaNotifier{},
 		lineNotifier{},
+		mqttNotifier{},
 		oncallNotifier{},
 		opsGenieNotifier{},
 		pagerDutyNotifier{},
@@ -36,6 +37,7 @@ var (
 		slackNotifier{},
 		snsNotifier{},
 		teamsNotifier{},
+		teamsWorkflowsNotifier{},
 		telegramNotifier{},
 		threemaNotifier{},
 		victorOpsNotifier{},
@@ -50,6 +52,8 @@ func resourceContactPoint() *common.Resource {
 		Description: `
 Manages Grafana Alerting contact points.
 
+Contact points are imported using their name, with or without the ` + "`name:`" + ` prefix (eg. ` + "`My Contact Point`" + ` or ` + "`name:My Contact Point`" + `).
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
 
@@ -61,7 +65,10 @@ This resource requires Grafana 9.1.0 or later.
 		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteContactPoint),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			// The ID of the contact points is already their name
+			StateContext: importOrgResourceByName(func(_ *goapi.GrafanaHTTPAPI, name string) (string, error) {
+				return name, nil
+			}, schema.ImportStatePassthroughContext),
 		},
 
 		SchemaVersion: 0,
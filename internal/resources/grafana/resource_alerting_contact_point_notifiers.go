@@ -619,6 +619,154 @@ func (o lineNotifier) unpack(raw interface{}, name string) *models.EmbeddedConta
 	}
 }
 
+type mqttNotifier struct{}
+
+var _ notifier = (*mqttNotifier)(nil)
+
+func (m mqttNotifier) meta() notifierMeta {
+	return notifierMeta{
+		field:        "mqtt",
+		typeStr:      "mqtt",
+		desc:         "A contact point that publishes notifications to an MQTT broker.",
+		secureFields: []string{"password"},
+	}
+}
+
+func (m mqttNotifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+	r.Schema["broker_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The URL of the MQTT broker, eg. `tcp://localhost:1883`.",
+	}
+	r.Schema["topic"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The MQTT topic to publish to.",
+	}
+	r.Schema["client_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The client ID to use when connecting to the broker. Defaults to a random value.",
+	}
+	r.Schema["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated message content to send. Only used when `message_format` is `text`.",
+	}
+	r.Schema["message_format"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+		Description:  "The format of the published messages. Supported: json (default) and text.",
+	}
+	r.Schema["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The user name to use when connecting to the broker.",
+	}
+	r.Schema["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The password to use when connecting to the broker.",
+	}
+	r.Schema["qos"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, 2),
+		Description:  "The quality of service to use when publishing: 0 (at most once, default), 1 (at least once) or 2 (exactly once).",
+	}
+	r.Schema["retain"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the broker should retain the last published message.",
+	}
+	r.Schema["insecure_skip_verify"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to skip the verification of the broker's TLS certificate.",
+	}
+	return r
+}
+
+func (m mqttNotifier) pack(p *models.EmbeddedContactPoint, data *schema.ResourceData) (interface{}, error) {
+	notifier := packCommonNotifierFields(p)
+	settings := p.Settings.(map[string]interface{})
+
+	packNotifierStringField(&settings, &notifier, "brokerUrl", "broker_url")
+	packNotifierStringField(&settings, &notifier, "topic", "topic")
+	packNotifierStringField(&settings, &notifier, "clientId", "client_id")
+	packNotifierStringField(&settings, &notifier, "message", "message")
+	packNotifierStringField(&settings, &notifier, "messageFormat", "message_format")
+	packNotifierStringField(&settings, &notifier, "username", "username")
+	packNotifierStringField(&settings, &notifier, "password", "password")
+	if v, ok := settings["qos"]; ok && v != nil {
+		switch typ := v.(type) {
+		case float64:
+			notifier["qos"] = int(typ)
+		case string:
+			qos, err := strconv.Atoi(typ)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse value of 'qos' to integer: %w", err)
+			}
+			notifier["qos"] = qos
+		default:
+			return nil, fmt.Errorf("unexpected type %T for 'qos': %v", typ, typ)
+		}
+		delete(settings, "qos")
+	}
+	if v, ok := settings["retain"]; ok && v != nil {
+		notifier["retain"] = v.(bool)
+		delete(settings, "retain")
+	}
+	if v, ok := settings["tlsConfig"]; ok && v != nil {
+		if tlsConfig, ok := v.(map[string]interface{}); ok {
+			if skip, ok := tlsConfig["insecureSkipVerify"].(bool); ok {
+				notifier["insecure_skip_verify"] = skip
+			}
+		}
+		delete(settings, "tlsConfig")
+	}
+
+	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, m, p.UID), m.meta().secureFields)
+
+	notifier["settings"] = packSettings(p)
+	return notifier, nil
+}
+
+func (m mqttNotifier) unpack(raw interface{}, name string) *models.EmbeddedContactPoint {
+	json := raw.(map[string]interface{})
+	uid, disableResolve, settings := unpackCommonNotifierFields(json)
+
+	unpackNotifierStringField(&json, &settings, "broker_url", "brokerUrl")
+	unpackNotifierStringField(&json, &settings, "topic", "topic")
+	unpackNotifierStringField(&json, &settings, "client_id", "clientId")
+	unpackNotifierStringField(&json, &settings, "message", "message")
+	unpackNotifierStringField(&json, &settings, "message_format", "messageFormat")
+	unpackNotifierStringField(&json, &settings, "username", "username")
+	unpackNotifierStringField(&json, &settings, "password", "password")
+	if v, ok := json["qos"]; ok && v != nil {
+		settings["qos"] = v.(int)
+	}
+	if v, ok := json["retain"]; ok && v != nil {
+		settings["retain"] = v.(bool)
+	}
+	if v, ok := json["insecure_skip_verify"]; ok && v != nil && v.(bool) {
+		settings["tlsConfig"] = map[string]interface{}{
+			"insecureSkipVerify": true,
+		}
+	}
+
+	return &models.EmbeddedContactPoint{
+		UID:                   uid,
+		Name:                  name,
+		Type:                  common.Ref(m.meta().typeStr),
+		DisableResolveMessage: disableResolve,
+		Settings:              settings,
+	}
+}
+
 type oncallNotifier struct {
 }
 
@@ -1751,6 +1899,78 @@ func (t teamsNotifier) unpack(raw interface{}, name string) *models.EmbeddedCont
 	}
 }
 
+type teamsWorkflowsNotifier struct{}
+
+var _ notifier = (*teamsWorkflowsNotifier)(nil)
+
+func (t teamsWorkflowsNotifier) meta() notifierMeta {
+	return notifierMeta{
+		field:        "teams_workflows",
+		typeStr:      "teams_workflows",
+		desc:         "A contact point that sends notifications to Microsoft Teams through a Workflows (Power Automate) webhook.",
+		secureFields: []string{"url"},
+	}
+}
+
+func (t teamsWorkflowsNotifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+	r.Schema["url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The URL of the HTTP trigger of the Teams workflow.",
+	}
+	r.Schema["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated message content to send.",
+	}
+	r.Schema["title"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated title of the message.",
+	}
+	r.Schema["section_title"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated subtitle for each message section.",
+	}
+	return r
+}
+
+func (t teamsWorkflowsNotifier) pack(p *models.EmbeddedContactPoint, data *schema.ResourceData) (interface{}, error) {
+	notifier := packCommonNotifierFields(p)
+	settings := p.Settings.(map[string]interface{})
+
+	packNotifierStringField(&settings, &notifier, "url", "url")
+	packNotifierStringField(&settings, &notifier, "message", "message")
+	packNotifierStringField(&settings, &notifier, "title", "title")
+	packNotifierStringField(&settings, &notifier, "sectiontitle", "section_title")
+
+	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, t, p.UID), t.meta().secureFields)
+
+	notifier["settings"] = packSettings(p)
+	return notifier, nil
+}
+
+func (t teamsWorkflowsNotifier) unpack(raw interface{}, name string) *models.EmbeddedContactPoint {
+	json := raw.(map[string]interface{})
+	uid, disableResolve, settings := unpackCommonNotifierFields(json)
+
+	unpackNotifierStringField(&json, &settings, "url", "url")
+	unpackNotifierStringField(&json, &settings, "message", "message")
+	unpackNotifierStringField(&json, &settings, "title", "title")
+	unpackNotifierStringField(&json, &settings, "section_title", "sectiontitle")
+
+	return &models.EmbeddedContactPoint{
+		UID:                   uid,
+		Name:                  name,
+		Type:                  common.Ref(t.meta().typeStr),
+		DisableResolveMessage: disableResolve,
+		Settings:              settings,
+	}
+}
+
 type telegramNotifier struct{}
 
 var _ notifier = (*telegramNotifier)(nil)
@@ -2,7 +2,10 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime"
@@ -22,7 +25,7 @@ func resourceNotificationPolicy() *common.Resource {
 		Description: `
 Sets the global notification policy for Grafana.
 
-!> This resource manages the entire notification policy tree, and will overwrite any existing policies.
+!> This resource manages the entire notification policy tree, and will overwrite any existing policies. To only manage some of the top-level policies, use the ` + "`grafana_notification_policy_route`" + ` resource instead.
 
 * [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#notification-policies)
@@ -236,12 +239,23 @@ func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := putPolicyTree(ctx, client, orgID, npt, data.Get("disable_provenance").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, PolicySingletonID))
+	return readNotificationPolicy(ctx, data, meta)
+}
+
+// putPolicyTree replaces the whole notification policy tree.
+// Requests are retried in non-default orgs because the alertmanager is provisioned asynchronously when the org is created.
+func putPolicyTree(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, npt *models.Route, disableProvenance bool) error {
 	putParams := provisioning.NewPutPolicyTreeParams().WithBody(npt)
-	if data.Get("disable_provenance").(bool) {
+	if disableProvenance {
 		putParams.SetXDisableProvenance(&provenanceDisabled)
 	}
 
-	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		_, err := client.Provisioning.PutPolicyTree(putParams)
 		if orgID > 1 && err != nil {
 			if apiError, ok := err.(*runtime.APIError); ok && (apiError.IsCode(500) || apiError.IsCode(404)) {
@@ -253,13 +267,64 @@ func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 		}
 		return nil
 	})
+	if err != nil {
+		// The API error doesn't say which reference is invalid, so look for it
+		if refErr := checkPolicyTreeReferences(client, npt); refErr != nil {
+			return fmt.Errorf("%w: %w", refErr, err)
+		}
+	}
+	return err
+}
 
+// checkPolicyTreeReferences returns an error naming the contact points and mute timings that are referenced by the policy tree but don't exist.
+// This can't be done at plan time, because the referenced resources may be created in the same apply.
+func checkPolicyTreeReferences(client *goapi.GrafanaHTTPAPI, npt *models.Route) error {
+	contactPointsResp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
 	if err != nil {
-		return diag.FromErr(err)
+		return nil
+	}
+	muteTimingsResp, err := client.Provisioning.GetMuteTimings()
+	if err != nil {
+		return nil
 	}
 
-	data.SetId(MakeOrgResourceID(orgID, PolicySingletonID))
-	return readNotificationPolicy(ctx, data, meta)
+	contactPoints := map[string]bool{}
+	for _, cp := range contactPointsResp.Payload {
+		contactPoints[cp.Name] = true
+	}
+	muteTimings := map[string]bool{}
+	for _, mt := range muteTimingsResp.Payload {
+		muteTimings[mt.Name] = true
+	}
+
+	var missingContactPoints, missingMuteTimings []string
+	var walk func(route *models.Route)
+	walk = func(route *models.Route) {
+		if route.Receiver != "" && !contactPoints[route.Receiver] && !slices.Contains(missingContactPoints, route.Receiver) {
+			missingContactPoints = append(missingContactPoints, route.Receiver)
+		}
+		for _, name := range route.MuteTimeIntervals {
+			if !muteTimings[name] && !slices.Contains(missingMuteTimings, name) {
+				missingMuteTimings = append(missingMuteTimings, name)
+			}
+		}
+		for _, child := range route.Routes {
+			walk(child)
+		}
+	}
+	walk(npt)
+
+	var problems []string
+	if len(missingContactPoints) > 0 {
+		problems = append(problems, fmt.Sprintf("contact points %q do not exist", missingContactPoints))
+	}
+	if len(missingMuteTimings) > 0 {
+		problems = append(problems, fmt.Sprintf("mute timings %q do not exist", missingMuteTimings))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the notification policy tree references resources that do not exist: %s", strings.Join(problems, ", "))
 }
 
 func deleteNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,248 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+// The attributes of a route, as packed and unpacked by packSpecificPolicy and unpackSpecificPolicy
+var notificationPolicyRouteKeys = []string{"contact_point", "group_by", "matcher", "mute_timings", "continue", "group_wait", "group_interval", "repeat_interval", "policy"}
+
+func resourceNotificationPolicyRoute() *common.Resource {
+	routeSchema := policySchema(supportedPolicyTreeDepth).Schema
+	routeSchema["matcher"].Optional = false
+	routeSchema["matcher"].Required = true
+	routeSchema["matcher"].ForceNew = true
+	routeSchema["matcher"].MinItems = 1
+	routeSchema["matcher"].Description = "Describes which labels this policy should match. When multiple matchers are supplied, an alert must match ALL matchers to be accepted by this policy. The matchers identify the policy within the notification policy tree, so they must be unique among top-level policies."
+	routeSchema["org_id"] = orgIDAttribute()
+	routeSchema["disable_provenance"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow modifying the notification policy tree from other sources than Terraform or the Grafana API. Provenance applies to the whole tree, so all resources managing it should use the same value.",
+	}
+
+	schema := &schema.Resource{
+		Description: `
+Manages a single top-level policy of the notification policy tree, identified by its matchers.
+The rest of the tree, including the default policy, is left untouched, which allows multiple teams to manage their own policies.
+New policies are added after the existing top-level policies.
+When importing, the matchers are given in the ` + "`<label><operator>\"<value>\"`" + ` format, separated by commas (eg. ` + "`team=\"backend\",env=~\"prod.*\"`" + `).
+
+!> This resource conflicts with the ` + "`grafana_notification_policy`" + ` resource, which manages the entire notification policy tree.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#notification-policies)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+
+		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](createNotificationPolicyRoute),
+		ReadContext:   readNotificationPolicyRoute,
+		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](updateNotificationPolicyRoute),
+		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteNotificationPolicyRoute),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema:        routeSchema,
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_notification_policy_route",
+		orgResourceIDString("matchers"),
+		schema,
+	)
+}
+
+func readNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, matchersStr := OAPIClientFromExistingOrgResource(meta, data.Id())
+	matchers, err := parsePolicyRouteMatchers(matchersStr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tree := resp.Payload
+
+	idx := findPolicyRoute(tree.Routes, matchers)
+	if idx < 0 {
+		return common.WarnMissing("notification policy route", data)
+	}
+
+	packed := packSpecificPolicy(tree.Routes[idx], supportedPolicyTreeDepth).(map[string]interface{})
+	for _, key := range notificationPolicyRouteKeys {
+		data.Set(key, packed[key])
+	}
+	data.Set("disable_provenance", tree.Provenance == "")
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.SetId(MakeOrgResourceID(orgID, formatPolicyRouteMatchers(matchers)))
+
+	return nil
+}
+
+func createNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+
+	route, err := unpackNotificationPolicyRoute(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tree := resp.Payload
+
+	if findPolicyRoute(tree.Routes, route.ObjectMatchers) >= 0 {
+		return diag.Errorf("a top-level notification policy with matchers %s already exists. Import it instead", formatPolicyRouteMatchers(route.ObjectMatchers))
+	}
+	tree.Routes = append(tree.Routes, route)
+
+	if err := putPolicyTree(ctx, client, orgID, tree, data.Get("disable_provenance").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, formatPolicyRouteMatchers(route.ObjectMatchers)))
+	return readNotificationPolicyRoute(ctx, data, meta)
+}
+
+func updateNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, matchersStr := OAPIClientFromExistingOrgResource(meta, data.Id())
+	matchers, err := parsePolicyRouteMatchers(matchersStr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	route, err := unpackNotificationPolicyRoute(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tree := resp.Payload
+
+	idx := findPolicyRoute(tree.Routes, matchers)
+	if idx < 0 {
+		return diag.Errorf("top-level notification policy with matchers %s not found", matchersStr)
+	}
+	tree.Routes[idx] = route
+
+	if err := putPolicyTree(ctx, client, orgID, tree, data.Get("disable_provenance").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readNotificationPolicyRoute(ctx, data, meta)
+}
+
+func deleteNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, matchersStr := OAPIClientFromExistingOrgResource(meta, data.Id())
+	matchers, err := parsePolicyRouteMatchers(matchersStr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tree := resp.Payload
+
+	idx := findPolicyRoute(tree.Routes, matchers)
+	if idx < 0 {
+		return nil
+	}
+	tree.Routes = slices.Delete(tree.Routes, idx, idx+1)
+
+	return diag.FromErr(putPolicyTree(ctx, client, orgID, tree, data.Get("disable_provenance").(bool)))
+}
+
+func unpackNotificationPolicyRoute(data *schema.ResourceData) (*models.Route, error) {
+	policy := map[string]interface{}{}
+	for _, key := range notificationPolicyRouteKeys {
+		policy[key] = data.Get(key)
+	}
+	return unpackSpecificPolicy(policy)
+}
+
+// findPolicyRoute returns the index of the route with the given matchers, regardless of their order, or -1 if there is none.
+func findPolicyRoute(routes []*models.Route, matchers models.ObjectMatchers) int {
+	want := formatPolicyRouteMatchers(matchers)
+	return slices.IndexFunc(routes, func(r *models.Route) bool {
+		return len(r.ObjectMatchers) > 0 && formatPolicyRouteMatchers(r.ObjectMatchers) == want
+	})
+}
+
+// formatPolicyRouteMatchers formats matchers in a canonical way (sorted, with quoted values), eg. `env="prod",team=~"a|b"`.
+func formatPolicyRouteMatchers(matchers models.ObjectMatchers) string {
+	formatted := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		formatted = append(formatted, m[0]+m[1]+strconv.Quote(m[2]))
+	}
+	slices.Sort(formatted)
+	return strings.Join(formatted, ",")
+}
+
+// parsePolicyRouteMatchers is the inverse of formatPolicyRouteMatchers.
+func parsePolicyRouteMatchers(s string) (models.ObjectMatchers, error) {
+	input := s
+	var matchers models.ObjectMatchers
+	for s != "" {
+		opIdx := strings.IndexAny(s, "=!")
+		if opIdx <= 0 {
+			return nil, fmt.Errorf("invalid matchers %q: expected a label followed by an operator", input)
+		}
+		label := s[:opIdx]
+		s = s[opIdx:]
+
+		var op string
+		for _, candidate := range []string{"=~", "!~", "!=", "="} {
+			if strings.HasPrefix(s, candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return nil, fmt.Errorf("invalid matchers %q: unknown operator for label %q", input, label)
+		}
+		s = s[len(op):]
+
+		quoted, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matchers %q: the value of label %q must be quoted", input, label)
+		}
+		value, _ := strconv.Unquote(quoted)
+		s = s[len(quoted):]
+		matchers = append(matchers, models.ObjectMatcher{label, op, value})
+
+		if s != "" {
+			if !strings.HasPrefix(s, ",") {
+				return nil, fmt.Errorf("invalid matchers %q: expected a comma after the value of label %q", input, label)
+			}
+			s = s[1:]
+		}
+	}
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("invalid matchers %q: at least one matcher is required", input)
+	}
+	return matchers, nil
+}
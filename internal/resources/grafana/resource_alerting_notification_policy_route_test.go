@@ -0,0 +1,101 @@
+package grafana_test
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccNotificationPolicyRoute_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var route models.Route
+	otherRoute := &models.Route{
+		Receiver:       "grafana-default-email",
+		ObjectMatchers: models.ObjectMatchers{{"team", "=", "frontend"}},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			alertingNotificationPolicyRouteCheckExists.destroyed(&route, nil),
+			// Policies that are not managed by the resource are kept
+			func(s *terraform.State) error {
+				client := grafanaTestClient()
+				if _, err := alertingNotificationPolicyRouteCheckExists.getResourceFunc(client, notificationPolicyRouteID(otherRoute)); err != nil {
+					return err
+				}
+				_, err := client.Provisioning.ResetPolicyTree()
+				return err
+			},
+		),
+		Steps: []resource.TestStep{
+			// Test creation.
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_notification_policy_route/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingNotificationPolicyRouteCheckExists.exists("grafana_notification_policy_route.backend", &route),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "id", `1:team="backend"`),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "contact_point", "Backend Team"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "group_by.0", "alertname"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "matcher.#", "1"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "repeat_interval", "3h"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "policy.#", "1"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "policy.0.matcher.0.value", "critical|page"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "policy.0.repeat_interval", "1h"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "grafana_notification_policy_route.backend",
+				ImportState:       true,
+				ImportStateId:     `team="backend"`,
+				ImportStateVerify: true,
+			},
+			// Test update, with a policy managed outside of the resource.
+			{
+				PreConfig: func() {
+					client := grafanaTestClient()
+					resp, err := client.Provisioning.GetPolicyTree()
+					if err != nil {
+						t.Fatal(err)
+					}
+					tree := resp.Payload
+					tree.Routes = append([]*models.Route{otherRoute}, tree.Routes...)
+					if _, err := client.Provisioning.PutPolicyTree(provisioning.NewPutPolicyTreeParams().WithBody(tree)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_notification_policy_route/resource.tf", map[string]string{
+					"3h": "2h",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					alertingNotificationPolicyRouteCheckExists.exists("grafana_notification_policy_route.backend", &route),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.backend", "repeat_interval", "2h"),
+					func(s *terraform.State) error {
+						_, err := alertingNotificationPolicyRouteCheckExists.getResourceFunc(grafanaTestClient(), notificationPolicyRouteID(otherRoute))
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+// notificationPolicyRouteID returns the matchers of a route, in the same format as the grafana_notification_policy_route ID
+func notificationPolicyRouteID(r *models.Route) string {
+	matchers := make([]string, 0, len(r.ObjectMatchers))
+	for _, m := range r.ObjectMatchers {
+		matchers = append(matchers, m[0]+m[1]+strconv.Quote(m[2]))
+	}
+	slices.Sort(matchers)
+	return strings.Join(matchers, ",")
+}
@@ -188,6 +188,18 @@ func TestAccNotificationPolicy_error(t *testing.T) {
 				// This tests that the API error message is propagated to the user.
 				ExpectError: regexp.MustCompile("400.+invalid object specification: receiver 'invalid' does not exist"),
 			},
+			{
+				Config: `resource "grafana_notification_policy" "test" {
+					group_by      = ["..."]
+					contact_point = "grafana-default-email"
+					policy {
+						contact_point = "invalid"
+						mute_timings  = ["invalid-mute-timing"]
+					}
+				  }`,
+				// This tests that the missing references are named.
+				ExpectError: regexp.MustCompile(`contact points \["invalid"\] do not exist, mute timings \["invalid-mute-timing"\] do not exist`),
+			},
 		},
 	})
 }
@@ -3,9 +3,11 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +45,7 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readAlertRuleGroup,
 		UpdateContext: putAlertRuleGroup,
 		DeleteContext: deleteAlertRuleGroup,
+		CustomizeDiff: ruleGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -117,8 +120,8 @@ This resource requires Grafana 9.1.0 or later.
 						},
 						"condition": {
 							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The `ref_id` of the query node in the `data` field to use as the alert condition.",
+							Optional:    true,
+							Description: "The `ref_id` of the query node in the `data` field to use as the alert condition. Required for alert rules, must not be set for recording rules.",
 						},
 						"data": {
 							Type:             schema.TypeList,
@@ -244,6 +247,26 @@ This resource requires Grafana 9.1.0 or later.
 								},
 							},
 						},
+						"record": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Settings for a recording rule. If set, the rule writes the result of a query node to a metric instead of alerting, and the alerting fields (`condition`, `for`, `no_data_state`, `exec_err_state` and `notification_settings`) are ignored. Available since Grafana 11.1, requires feature flag 'grafanaManagedRecordingRules' enabled.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the metric to write to.",
+									},
+									"from": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The `ref_id` of the query node in the `data` field to use as the source of the metric.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -279,10 +302,13 @@ func listRuleGroups(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int
 		return nil, err
 	}
 
-	var ids []string
+	// Rules are listed across all folders, including nested ones, so every group is found regardless of its folder's depth.
+	// Sort the IDs so that generated resources are stable across runs.
+	ids := make([]string, 0, len(idMap))
 	for id := range idMap {
 		ids = append(ids, id)
 	}
+	slices.Sort(ids)
 
 	return ids, nil
 }
@@ -304,14 +330,22 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 	data.Set("name", g.Title)
 	data.Set("folder_uid", g.FolderUID)
 	data.Set("interval_seconds", g.Interval)
-	disableProvenance := true
-	rules := make([]interface{}, 0, len(g.Rules))
-	for _, r := range g.Rules {
-		ruleResp, err := client.Provisioning.GetAlertRule(r.UID) // We need to get the rule through a separate API call to get the provenance.
+	// We need to get the rules through separate API calls to get their provenance.
+	provisionedRules := make([]*models.ProvisionedAlertRule, len(g.Rules))
+	if err := common.ForEachParallel(ctx, g.Rules, func(ctx context.Context, i int, r *models.ProvisionedAlertRule) error {
+		ruleResp, err := client.Provisioning.GetAlertRule(r.UID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-		r := ruleResp.Payload
+		provisionedRules[i] = ruleResp.Payload
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	disableProvenance := true
+	rules := make([]interface{}, 0, len(provisionedRules))
+	for _, r := range provisionedRules {
 		data.Set("org_id", strconv.FormatInt(*r.OrgID, 10))
 		packed, err := packAlertRule(r)
 		if err != nil {
@@ -439,6 +473,32 @@ func deleteAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta i
 	return nil
 }
 
+// ruleGroupCustomizeDiff checks that the alert rules have a condition. Recording rules don't need one.
+func ruleGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The rules are read from the config, since a condition that isn't known yet is still set
+	rules := d.GetRawConfig().GetAttr("rule")
+	if rules.IsNull() || !rules.IsKnown() {
+		return nil
+	}
+	for it := rules.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		if !rule.IsKnown() || rule.IsNull() {
+			continue
+		}
+		if record := rule.GetAttr("record"); !record.IsKnown() || (!record.IsNull() && record.LengthInt() > 0) {
+			continue
+		}
+		if condition := rule.GetAttr("condition"); condition.IsNull() || (condition.IsKnown() && condition.AsString() == "") {
+			name := rule.GetAttr("name")
+			if !name.IsKnown() || name.IsNull() {
+				return errors.New("`condition` is required for alert rules (rules without `record`)")
+			}
+			return fmt.Errorf("rule %q: `condition` is required for alert rules (rules without `record`)", name.AsString())
+		}
+	}
+	return nil
+}
+
 func diffSuppressJSON(k, oldValue, newValue string, data *schema.ResourceData) bool {
 	var o, n interface{}
 	d := json.NewDecoder(strings.NewReader(oldValue))
@@ -470,6 +530,16 @@ func packAlertRule(r *models.ProvisionedAlertRule) (interface{}, error) {
 		"is_paused":      r.IsPaused,
 	}
 
+	if r.Record != nil {
+		json["record"] = []interface{}{map[string]interface{}{
+			"metric": *r.Record.Metric,
+			"from":   *r.Record.From,
+		}}
+		// The alerting fields are cleared by the API for recording rules. Keep the defaults to avoid diffs.
+		json["no_data_state"] = "NoData"
+		json["exec_err_state"] = "Alerting"
+	}
+
 	ns, err := packNotificationSettings(r.NotificationSettings)
 	if err != nil {
 		return nil, err
@@ -516,6 +586,7 @@ func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID
 		Annotations:          unpackMap(json["annotations"]),
 		IsPaused:             json["is_paused"].(bool),
 		NotificationSettings: ns,
+		Record:               unpackRecord(json["record"]),
 	}
 
 	return &rule, nil
@@ -629,6 +700,22 @@ func unpackMap(raw interface{}) map[string]string {
 	return result
 }
 
+func unpackRecord(p interface{}) *models.Record {
+	if p == nil {
+		return nil
+	}
+	list := p.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	jsonData := list[0].(map[string]interface{})
+	return &models.Record{
+		Metric: common.Ref(jsonData["metric"].(string)),
+		From:   common.Ref(jsonData["from"].(string)),
+	}
+}
+
 func packNotificationSettings(settings *models.AlertRuleNotificationSettings) (interface{}, error) {
 	if settings == nil {
 		return nil, nil
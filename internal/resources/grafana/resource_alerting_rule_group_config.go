@@ -0,0 +1,489 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+// The structs below mirror the provisioning file format, as exported by the Grafana UI and the export API.
+// The client models can't be used to decode exports because durations are exported as strings (eg. `1m`).
+type ruleGroupExportFile struct {
+	APIVersion int64              `json:"apiVersion" yaml:"apiVersion"`
+	Groups     []*ruleGroupExport `json:"groups" yaml:"groups"`
+}
+
+type ruleGroupExport struct {
+	Name     string        `json:"name" yaml:"name"`
+	Interval string        `json:"interval" yaml:"interval"`
+	Rules    []*ruleExport `json:"rules" yaml:"rules"`
+}
+
+type ruleExport struct {
+	UID                  string                          `json:"uid,omitempty" yaml:"uid"`
+	Title                string                          `json:"title" yaml:"title"`
+	Condition            string                          `json:"condition" yaml:"condition"`
+	Data                 []*ruleQueryExport              `json:"data" yaml:"data"`
+	NoDataState          string                          `json:"noDataState" yaml:"noDataState"`
+	ExecErrState         string                          `json:"execErrState" yaml:"execErrState"`
+	For                  string                          `json:"for" yaml:"for"`
+	Annotations          map[string]string               `json:"annotations,omitempty" yaml:"annotations"`
+	Labels               map[string]string               `json:"labels,omitempty" yaml:"labels"`
+	IsPaused             bool                            `json:"isPaused,omitempty" yaml:"isPaused"`
+	NotificationSettings *ruleNotificationSettingsExport `json:"notification_settings,omitempty" yaml:"notification_settings"`
+	Record               *ruleRecordExport               `json:"record,omitempty" yaml:"record"`
+}
+
+type ruleRecordExport struct {
+	Metric string `json:"metric" yaml:"metric"`
+	From   string `json:"from" yaml:"from"`
+}
+
+type ruleQueryExport struct {
+	RefID             string                      `json:"refId" yaml:"refId"`
+	QueryType         string                      `json:"queryType,omitempty" yaml:"queryType"`
+	RelativeTimeRange ruleRelativeTimeRangeExport `json:"relativeTimeRange" yaml:"relativeTimeRange"`
+	DatasourceUID     string                      `json:"datasourceUid" yaml:"datasourceUid"`
+	Model             interface{}                 `json:"model" yaml:"model"`
+}
+
+type ruleRelativeTimeRangeExport struct {
+	From int64 `json:"from" yaml:"from"`
+	To   int64 `json:"to" yaml:"to"`
+}
+
+type ruleNotificationSettingsExport struct {
+	Receiver          string   `json:"receiver" yaml:"receiver"`
+	GroupBy           []string `json:"group_by,omitempty" yaml:"group_by"`
+	GroupWait         string   `json:"group_wait,omitempty" yaml:"group_wait"`
+	GroupInterval     string   `json:"group_interval,omitempty" yaml:"group_interval"`
+	RepeatInterval    string   `json:"repeat_interval,omitempty" yaml:"repeat_interval"`
+	MuteTimeIntervals []string `json:"mute_time_intervals,omitempty" yaml:"mute_time_intervals"`
+}
+
+func resourceRuleGroupConfig() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages Grafana Alerting rule groups from their provisioning file representation, as exported by the Grafana UI or API (JSON or YAML).
+This allows moving rules authored in the UI into Terraform without translating them to the ` + "`grafana_rule_group`" + ` syntax.
+
+The export must contain exactly one rule group. The folder and organization of the export are ignored, ` + "`folder_uid` and `org_id`" + ` are used instead.
+Rules without a UID keep the UID that Grafana assigned to them.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/export-alerting-resources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#alert-rules)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+		CreateContext: putAlertRuleGroupConfig,
+		ReadContext:   readAlertRuleGroupConfig,
+		UpdateContext: putAlertRuleGroupConfig,
+		DeleteContext: deleteAlertRuleGroup,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.ForceNewIfChange("config", func(ctx context.Context, oldValue, newValue, meta interface{}) bool {
+			oldGroup, oldErr := parseRuleGroupExport(oldValue.(string))
+			newGroup, newErr := parseRuleGroupExport(newValue.(string))
+			return oldErr == nil && newErr == nil && oldGroup.Name != newGroup.Name
+		}),
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"folder_uid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The UID of the folder that the group belongs to.",
+				ValidateFunc: folderUIDValidation,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The rule group, in the provisioning file format (JSON or YAML). It is stored in a normalized JSON form.",
+				ValidateDiagFunc: validateRuleGroupExport,
+				StateFunc:        normalizeRuleGroupExport,
+				DiffSuppressFunc: diffSuppressRuleGroupExport,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the rule group, as given in the config.",
+			},
+			"disable_provenance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow modifying the rule group from other sources than Terraform or the Grafana API.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_rule_group_config",
+		resourceRuleGroupID,
+		schema,
+	)
+}
+
+func readAlertRuleGroupConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, idWithoutOrg := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	folderUID, title, found := strings.Cut(idWithoutOrg, common.ResourceIDSeparator)
+	if !found {
+		return diag.Errorf("invalid ID %q", idWithoutOrg)
+	}
+
+	resp, err := client.Provisioning.GetAlertRuleGroup(title, folderUID)
+	if err, shouldReturn := common.CheckReadError("rule group", data, err); shouldReturn {
+		return err
+	}
+
+	g := resp.Payload
+	group := &ruleGroupExport{
+		Name:     g.Title,
+		Interval: (time.Duration(g.Interval) * time.Second).String(),
+	}
+	disableProvenance := true
+	for _, r := range g.Rules {
+		ruleResp, err := client.Provisioning.GetAlertRule(r.UID) // We need to get the rule through a separate API call to get the provenance.
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r := ruleResp.Payload
+		if r.Provenance != "" {
+			disableProvenance = false
+		}
+		group.Rules = append(group.Rules, packRuleExport(r))
+	}
+
+	config, err := formatRuleGroupExport(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set("config", config)
+	data.Set("name", g.Title)
+	data.Set("folder_uid", g.FolderUID)
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.Set("disable_provenance", disableProvenance)
+	data.SetId(resourceRuleGroupID.Make(orgID, folderUID, title))
+
+	return nil
+}
+
+func putAlertRuleGroupConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+
+	group, err := parseRuleGroupExport(data.Get("config").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	folder := data.Get("folder_uid").(string)
+
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		existingUIDs := map[string]string{}
+		existing, err := client.Provisioning.GetAlertRuleGroup(group.Name, folder)
+		if err == nil {
+			if data.IsNewResource() {
+				// The API overwrites the existing rule group, which is not expected of a TF provider.
+				return retry.NonRetryableError(fmt.Errorf("rule group with name %q already exists", group.Name))
+			}
+			for _, r := range existing.Payload.Rules {
+				existingUIDs[*r.Title] = r.UID
+			}
+		} else if !common.IsNotFoundError(err) {
+			return retry.NonRetryableError(err)
+		}
+
+		rules := make([]*models.ProvisionedAlertRule, 0, len(group.Rules))
+		for _, r := range group.Rules {
+			rule, err := unpackRuleExport(r, group.Name, folder, orgID)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			if rule.UID == "" {
+				rule.UID = existingUIDs[*rule.Title]
+			}
+			rules = append(rules, rule)
+		}
+
+		interval, err := strfmt.ParseDuration(group.Interval)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("invalid interval %q: %w", group.Interval, err))
+		}
+
+		putParams := provisioning.NewPutAlertRuleGroupParams().
+			WithFolderUID(folder).
+			WithGroup(group.Name).WithBody(&models.AlertRuleGroup{
+			Title:     group.Name,
+			FolderUID: folder,
+			Rules:     rules,
+			Interval:  int64(interval / time.Second),
+		})
+
+		if data.Get("disable_provenance").(bool) {
+			putParams.SetXDisableProvenance(&provenanceDisabled)
+		}
+
+		resp, err := client.Provisioning.PutAlertRuleGroup(putParams)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+
+		data.SetId(resourceRuleGroupID.Make(orgID, resp.Payload.FolderUID, resp.Payload.Title))
+		return nil
+	})
+
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	return readAlertRuleGroupConfig(ctx, data, meta)
+}
+
+// parseRuleGroupExport parses a JSON or YAML provisioning file containing a single rule group, and fills in the defaults applied by Grafana.
+func parseRuleGroupExport(config string) (*ruleGroupExport, error) {
+	var file ruleGroupExportFile
+	// YAML is a superset of JSON, so both formats are decoded the same way
+	if err := yaml.Unmarshal([]byte(config), &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rule group config: %w", err)
+	}
+	if len(file.Groups) != 1 {
+		return nil, fmt.Errorf("the rule group config must contain exactly one group, got %d", len(file.Groups))
+	}
+
+	group := file.Groups[0]
+	if group.Name == "" {
+		return nil, fmt.Errorf("the rule group must have a name")
+	}
+	if len(group.Rules) == 0 {
+		return nil, fmt.Errorf("the rule group %q must contain at least one rule", group.Name)
+	}
+	interval, err := strfmt.ParseDuration(group.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q in rule group %q: %w", group.Interval, group.Name, err)
+	}
+	group.Interval = interval.String()
+
+	titles := map[string]bool{}
+	for _, r := range group.Rules {
+		if titles[r.Title] {
+			return nil, fmt.Errorf("rule with name %q is defined more than once", r.Title)
+		}
+		titles[r.Title] = true
+
+		if r.Record != nil {
+			// The alerting fields are cleared by the API for recording rules
+			r.Condition = ""
+			r.NoDataState = ""
+			r.ExecErrState = ""
+			r.For = ""
+			r.NotificationSettings = nil
+		} else {
+			if r.NoDataState == "" {
+				r.NoDataState = "NoData"
+			}
+			if r.ExecErrState == "" {
+				r.ExecErrState = "Alerting"
+			}
+		}
+		forStr := r.For
+		if forStr == "" {
+			forStr = "0"
+		}
+		forDuration, err := strfmt.ParseDuration(forStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q in rule %q: %w", r.For, r.Title, err)
+		}
+		r.For = forDuration.String()
+
+		for _, q := range r.Data {
+			model, err := json.Marshal(q.Model)
+			if err != nil {
+				return nil, fmt.Errorf("invalid model for query %q in rule %q: %w", q.RefID, r.Title, err)
+			}
+			if err := json.Unmarshal([]byte(normalizeModelJSON(string(model))), &q.Model); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return group, nil
+}
+
+// formatRuleGroupExport formats a rule group in the normalized form stored in the state.
+func formatRuleGroupExport(group *ruleGroupExport) (string, error) {
+	config, err := json.Marshal(ruleGroupExportFile{
+		APIVersion: 1,
+		Groups:     []*ruleGroupExport{group},
+	})
+	return string(config), err
+}
+
+func validateRuleGroupExport(i interface{}, _ cty.Path) diag.Diagnostics {
+	_, err := parseRuleGroupExport(i.(string))
+	return diag.FromErr(err)
+}
+
+func normalizeRuleGroupExport(i interface{}) string {
+	group, err := parseRuleGroupExport(i.(string))
+	if err != nil {
+		// This should never happen if the field passes validation.
+		return i.(string)
+	}
+	config, err := formatRuleGroupExport(group)
+	if err != nil {
+		return i.(string)
+	}
+	return config
+}
+
+// diffSuppressRuleGroupExport ignores the UIDs assigned by Grafana to rules that were configured without one.
+func diffSuppressRuleGroupExport(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldGroup, err := parseRuleGroupExport(oldValue)
+	if err != nil {
+		return false
+	}
+	newGroup, err := parseRuleGroupExport(newValue)
+	if err != nil {
+		return false
+	}
+	for _, r := range oldGroup.Rules {
+		for _, newRule := range newGroup.Rules {
+			if newRule.UID == "" && newRule.Title == r.Title {
+				r.UID = ""
+			}
+		}
+	}
+	oldConfig, oldErr := formatRuleGroupExport(oldGroup)
+	newConfig, newErr := formatRuleGroupExport(newGroup)
+	return oldErr == nil && newErr == nil && oldConfig == newConfig
+}
+
+func packRuleExport(r *models.ProvisionedAlertRule) *ruleExport {
+	rule := &ruleExport{
+		UID:          r.UID,
+		Title:        *r.Title,
+		Condition:    *r.Condition,
+		NoDataState:  *r.NoDataState,
+		ExecErrState: *r.ExecErrState,
+		For:          time.Duration(*r.For).String(),
+		Annotations:  r.Annotations,
+		Labels:       r.Labels,
+		IsPaused:     r.IsPaused,
+	}
+	if r.Record != nil {
+		rule.Record = &ruleRecordExport{
+			Metric: *r.Record.Metric,
+			From:   *r.Record.From,
+		}
+	}
+	for _, q := range r.Data {
+		if q == nil {
+			continue
+		}
+		query := &ruleQueryExport{
+			RefID:         q.RefID,
+			QueryType:     q.QueryType,
+			DatasourceUID: q.DatasourceUID,
+			Model:         q.Model,
+		}
+		if q.RelativeTimeRange != nil {
+			query.RelativeTimeRange = ruleRelativeTimeRangeExport{
+				From: int64(q.RelativeTimeRange.From),
+				To:   int64(q.RelativeTimeRange.To),
+			}
+		}
+		if model, err := json.Marshal(q.Model); err == nil {
+			json.Unmarshal([]byte(normalizeModelJSON(string(model))), &query.Model)
+		}
+		rule.Data = append(rule.Data, query)
+	}
+	if ns := r.NotificationSettings; ns != nil {
+		rule.NotificationSettings = &ruleNotificationSettingsExport{
+			GroupBy:           ns.GroupBy,
+			GroupWait:         ns.GroupWait,
+			GroupInterval:     ns.GroupInterval,
+			RepeatInterval:    ns.RepeatInterval,
+			MuteTimeIntervals: ns.MuteTimeIntervals,
+		}
+		if ns.Receiver != nil {
+			rule.NotificationSettings.Receiver = *ns.Receiver
+		}
+	}
+	return rule
+}
+
+func unpackRuleExport(r *ruleExport, groupName string, folderUID string, orgID int64) (*models.ProvisionedAlertRule, error) {
+	forDuration, err := strfmt.ParseDuration(r.For)
+	if err != nil {
+		return nil, err
+	}
+
+	// These states are ignored for recording rules, but they must still be valid
+	noDataState, execErrState := r.NoDataState, r.ExecErrState
+	if r.Record != nil {
+		noDataState, execErrState = "NoData", "Alerting"
+	}
+
+	rule := &models.ProvisionedAlertRule{
+		UID:          r.UID,
+		Title:        common.Ref(r.Title),
+		FolderUID:    common.Ref(folderUID),
+		RuleGroup:    common.Ref(groupName),
+		OrgID:        common.Ref(orgID),
+		ExecErrState: common.Ref(execErrState),
+		NoDataState:  common.Ref(noDataState),
+		For:          common.Ref(strfmt.Duration(forDuration)),
+		Condition:    common.Ref(r.Condition),
+		Labels:       r.Labels,
+		Annotations:  r.Annotations,
+		IsPaused:     r.IsPaused,
+	}
+	for _, q := range r.Data {
+		rule.Data = append(rule.Data, &models.AlertQuery{
+			RefID:         q.RefID,
+			QueryType:     q.QueryType,
+			DatasourceUID: q.DatasourceUID,
+			RelativeTimeRange: &models.RelativeTimeRange{
+				From: models.Duration(q.RelativeTimeRange.From),
+				To:   models.Duration(q.RelativeTimeRange.To),
+			},
+			Model: q.Model,
+		})
+	}
+	if r.Record != nil {
+		rule.Record = &models.Record{
+			Metric: common.Ref(r.Record.Metric),
+			From:   common.Ref(r.Record.From),
+		}
+	}
+	if ns := r.NotificationSettings; ns != nil {
+		rule.NotificationSettings = &models.AlertRuleNotificationSettings{
+			Receiver:          common.Ref(ns.Receiver),
+			GroupBy:           ns.GroupBy,
+			GroupWait:         ns.GroupWait,
+			GroupInterval:     ns.GroupInterval,
+			RepeatInterval:    ns.RepeatInterval,
+			MuteTimeIntervals: ns.MuteTimeIntervals,
+		}
+	}
+	return rule, nil
+}
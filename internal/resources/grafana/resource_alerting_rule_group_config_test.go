@@ -0,0 +1,103 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccRuleGroupConfig_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var group models.AlertRuleGroup
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_rule_group_config/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group_config.my_exported_group", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group_config.my_exported_group", "name", "My Exported Group"),
+					resource.TestCheckResourceAttr("grafana_rule_group_config.my_exported_group", "org_id", "1"),
+					resource.TestCheckResourceAttrPair("grafana_rule_group_config.my_exported_group", "folder_uid", "grafana_folder.rule_folder", "uid"),
+					func(s *terraform.State) error {
+						if group.Interval != 60 || len(group.Rules) != 1 || *group.Rules[0].Title != "My Exported Rule" {
+							return fmt.Errorf("unexpected rule group: %+v", group)
+						}
+						return nil
+					},
+				),
+			},
+			// The same group in the JSON format, with different but equivalent values, doesn't produce a diff
+			{
+				Config:   testAccRuleGroupConfigJSON,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "grafana_rule_group_config.my_exported_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the rule
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group_config/resource.tf", map[string]string{
+					"It is high": "It is very high",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group_config.my_exported_group", &group),
+					func(s *terraform.State) error {
+						if summary := group.Rules[0].Annotations["summary"]; summary != "It is very high" {
+							return fmt.Errorf("unexpected summary: %s", summary)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccRuleGroupConfigJSON = `
+resource "grafana_folder" "rule_folder" {
+  title = "My Exported Rule Folder"
+}
+
+resource "grafana_rule_group_config" "my_exported_group" {
+  folder_uid = grafana_folder.rule_folder.uid
+  config     = jsonencode({
+    apiVersion = 1
+    groups = [{
+      name     = "My Exported Group"
+      interval = "60s"
+      rules = [{
+        title     = "My Exported Rule"
+        condition = "B"
+        data = [
+          {
+            refId             = "A"
+            relativeTimeRange = { from = 600, to = 0 }
+            datasourceUid     = "PD8C576611E62080A"
+            model             = { refId = "A", hide = false, intervalMs = 1000, maxDataPoints = 43200 }
+          },
+          {
+            refId         = "B"
+            datasourceUid = "__expr__"
+            model         = { refId = "B", type = "math", expression = "$A > 3" }
+          },
+        ]
+        execErrState = "Error"
+        for          = "300s"
+        annotations  = { summary = "It is high" }
+        labels       = { team = "backend" }
+      }]
+    }]
+  })
+}
+`
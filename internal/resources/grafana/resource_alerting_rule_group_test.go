@@ -458,6 +458,47 @@ resource "grafana_rule_group" "first" {
 	})
 }
 
+func TestAccAlertRule_missingCondition(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_folder" "test" {
+	title = "%[1]s"
+}
+
+resource "grafana_rule_group" "test" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.test.uid
+	interval_seconds = 60
+	rule {
+		name = "My Alert Rule"
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model = jsonencode({
+				refId = "A"
+			})
+		}
+	}
+}
+				`, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule "My Alert Rule": ` + "`condition`" + ` is required for alert rules`),
+			},
+		},
+	})
+}
+
 func TestAccAlertRule_moveRules(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
 
@@ -642,6 +683,29 @@ func TestAccAlertRule_zeroSeconds(t *testing.T) {
 	})
 }
 
+func TestAccAlertRule_nestedFolder(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	var group models.AlertRuleGroup
+	var name = acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertRuleNestedFolder(name),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestCheckResourceAttrPair("grafana_rule_group.my_rule_group", "folder_uid", "grafana_folder.child", "uid"),
+					// Rule groups in nested folders are listed too
+					testutils.CheckLister("grafana_rule_group.my_rule_group"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlertRule_NotificationSettings(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=10.4.0")
 
@@ -675,6 +739,42 @@ func TestAccAlertRule_NotificationSettings(t *testing.T) {
 	})
 }
 
+func TestAccAlertRule_recordingRule(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.3.0")
+
+	var group models.AlertRuleGroup
+	var name = acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertRuleRecordingRule(name, "my_metric"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_rule_group", "rule.#", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_rule_group", "rule.0.condition", ""),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_rule_group", "rule.0.record.0.metric", "my_metric"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_rule_group", "rule.0.record.0.from", "A"),
+				),
+			},
+			{
+				Config: testAccAlertRuleRecordingRule(name, "my_other_metric"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_rule_group", "rule.0.record.0.metric", "my_other_metric"),
+				),
+			},
+			{
+				ResourceName:      "grafana_rule_group.my_rule_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAlertRuleGroupInOrgConfig(name string, interval int, disableProvenance bool) string {
 	return fmt.Sprintf(`
 resource "grafana_organization" "test" {
@@ -760,6 +860,43 @@ resource "grafana_rule_group" "my_rule_group" {
 }`, name)
 }
 
+func testAccAlertRuleNestedFolder(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "parent" {
+	title = "%[1]s"
+}
+
+resource "grafana_folder" "child" {
+	title             = "%[1]s-child"
+	parent_folder_uid = grafana_folder.parent.uid
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.child.uid
+	interval_seconds = 60
+
+	rule {
+		name      = "My Alert"
+		condition = "A"
+		for       = "0s"
+
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 0
+				to   = 0
+			}
+			datasource_uid = "__expr__"
+			model = jsonencode({
+				expression = "0 > 0"
+				type       = "math"
+			})
+		}
+	}
+}`, name)
+}
+
 func testAccAlertRuleWithNotificationSettings(name string, groupBy []string) string {
 	gr := ""
 	if len(groupBy) > 0 {
@@ -825,3 +962,38 @@ resource "grafana_rule_group" "my_rule_group" {
 	}
 }`, name, gr)
 }
+
+func testAccAlertRuleRecordingRule(name, metric string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "rule_folder" {
+	title = "%[1]s"
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.rule_folder.uid
+	interval_seconds = 60
+
+	rule {
+		name = "My Recording Rule"
+
+		record {
+			metric = "%[2]s"
+			from   = "A"
+		}
+
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 0
+				to   = 0
+			}
+			datasource_uid = "__expr__"
+			model = jsonencode({
+				expression = "1 + 1"
+				type       = "math"
+			})
+		}
+	}
+}`, name, metric)
+}
@@ -0,0 +1,267 @@
+package grafana
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+func resourceSilence() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages silences of the Grafana Alertmanager. Silences mute notifications of the alerts that match all of their matchers for a given time window.
+
+The time window is either fixed, with ` + "`starts_at` and `ends_at`" + `, or relative to when the silence is created, with ` + "`duration`" + `.
+Changing the duration creates a new silence, starting when the change is applied.
+Expired silences are kept in the state, even after the Alertmanager removes them, so that they are not created again by the next apply.
+To silence the alerts again, change the time window of the silence or its duration.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/configure-notifications/create-silence/)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+
+		CreateContext: putSilence,
+		ReadContext:   readSilence,
+		UpdateContext: putSilence,
+		DeleteContext: deleteSilence,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"matcher": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Describes which alerts should be silenced. An alert must match ALL matchers to be silenced.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the label to match against.",
+						},
+						"match": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The operator to apply when matching values of the given label. Allowed operators are `=` for equality, `!=` for negated equality, `=~` for regex equality, and `!~` for negated regex equality.",
+							ValidateFunc: validation.StringInSlice([]string{"=", "!=", "=~", "!~"}, false),
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The label value to match against.",
+						},
+					},
+				},
+			},
+			"starts_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"duration"},
+				Description:      "The start of the silence, in RFC3339 format. Defaults to when the silence is created. Start times in the past are replaced by the creation time.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: diffSuppressSilenceStartsAt,
+			},
+			"ends_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"ends_at", "duration"},
+				Description:      "The end of the silence, in RFC3339 format.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: diffSuppressSilenceTime,
+			},
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The duration of the silence, starting when it is created (eg. `2h`). Changing it creates a new silence.",
+				ValidateDiagFunc: common.ValidateDurationWithDays,
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The reason for the silence.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Terraform",
+				Description: "The author of the silence.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the silence: `pending`, `active` or `expired`.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_alerting_silence",
+		orgResourceIDString("id"),
+		schema,
+	)
+}
+
+type postableSilence struct {
+	ID        string                   `json:"id,omitempty"`
+	Matchers  []postableSilenceMatcher `json:"matchers"`
+	StartsAt  strfmt.DateTime          `json:"startsAt"`
+	EndsAt    strfmt.DateTime          `json:"endsAt"`
+	CreatedBy string                   `json:"createdBy"`
+	Comment   string                   `json:"comment"`
+}
+
+// The client model omits `isEqual` when false, which the Alertmanager then defaults to true
+type postableSilenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsEqual bool   `json:"isEqual"`
+	IsRegex bool   `json:"isRegex"`
+}
+
+type postSilenceResult struct {
+	SilenceID string `json:"silenceID"`
+}
+
+func readSilence(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, id := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	var silence models.GettableSilence
+	err := submitAPIRequest(ctx, client, "GetSilence", http.MethodGet, "/alertmanager/grafana/api/v2/silence/"+url.PathEscape(id), nil, &silence)
+	// Expired silences are removed by the Alertmanager after a retention period, they are kept as is in the state
+	if common.IsNotFoundError(err) && data.Get("state").(string) == "expired" {
+		return nil
+	}
+	if err, shouldReturn := common.CheckReadError("silence", data, err); shouldReturn {
+		return err
+	}
+
+	matchers := make([]interface{}, 0, len(silence.Matchers))
+	for _, m := range silence.Matchers {
+		matchers = append(matchers, map[string]interface{}{
+			"label": *m.Name,
+			"match": packSilenceMatchOperator(m),
+			"value": *m.Value,
+		})
+	}
+	data.Set("matcher", matchers)
+	data.Set("starts_at", time.Time(*silence.StartsAt).Format(time.RFC3339))
+	data.Set("ends_at", time.Time(*silence.EndsAt).Format(time.RFC3339))
+	data.Set("comment", *silence.Comment)
+	data.Set("created_by", *silence.CreatedBy)
+	if silence.Status != nil {
+		data.Set("state", *silence.Status.State)
+	}
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.SetId(MakeOrgResourceID(orgID, *silence.ID))
+
+	return nil
+}
+
+func putSilence(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+
+	silence := postableSilence{
+		Comment:   data.Get("comment").(string),
+		CreatedBy: data.Get("created_by").(string),
+	}
+	// Expired silences can't be updated, and may have been removed by the Alertmanager, a new silence is created instead
+	if !data.IsNewResource() && data.Get("state").(string) != "expired" {
+		_, silence.ID = SplitOrgResourceID(data.Id())
+	}
+
+	for _, raw := range data.Get("matcher").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		op := m["match"].(string)
+		silence.Matchers = append(silence.Matchers, postableSilenceMatcher{
+			Name:    m["label"].(string),
+			Value:   m["value"].(string),
+			IsEqual: op == "=" || op == "=~",
+			IsRegex: op == "=~" || op == "!~",
+		})
+	}
+
+	startsAt := time.Now()
+	if v := data.Get("starts_at").(string); v != "" {
+		startsAt, _ = time.Parse(time.RFC3339, v)
+	}
+	var endsAt time.Time
+	if v, ok := data.GetOk("duration"); ok && data.IsNewResource() {
+		duration, err := strfmt.ParseDuration(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		endsAt = startsAt.Add(duration)
+	} else {
+		endsAt, _ = time.Parse(time.RFC3339, data.Get("ends_at").(string))
+	}
+	silence.StartsAt = strfmt.DateTime(startsAt)
+	silence.EndsAt = strfmt.DateTime(endsAt)
+
+	// The Alertmanager may replace the silence with a new one when updating it, so the ID can change
+	var result postSilenceResult
+	if err := submitAPIRequest(ctx, client, "PostSilence", http.MethodPost, "/alertmanager/grafana/api/v2/silences", silence, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, result.SilenceID))
+	return readSilence(ctx, data, meta)
+}
+
+func deleteSilence(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, id := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	// Expired silences can't be expired again, they are removed by the Alertmanager after a retention period
+	if data.Get("state").(string) == "expired" {
+		return nil
+	}
+
+	err := submitAPIRequest(ctx, client, "DeleteSilence", http.MethodDelete, "/alertmanager/grafana/api/v2/silence/"+url.PathEscape(id), nil, nil)
+	diag, _ := common.CheckReadError("silence", data, err)
+	return diag
+}
+
+func packSilenceMatchOperator(m *models.Matcher) string {
+	op := "="
+	if !m.IsEqual {
+		op = "!="
+	}
+	if m.IsRegex != nil && *m.IsRegex {
+		op = op[:1] + "~"
+	}
+	return op
+}
+
+func diffSuppressSilenceTime(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldTime, oldErr := time.Parse(time.RFC3339, oldValue)
+	newTime, newErr := time.Parse(time.RFC3339, newValue)
+	return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+}
+
+// diffSuppressSilenceStartsAt also ignores start times in the past, which are replaced by the creation time of the silence.
+func diffSuppressSilenceStartsAt(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if diffSuppressSilenceTime(k, oldValue, newValue, d) {
+		return true
+	}
+	oldTime, oldErr := time.Parse(time.RFC3339, oldValue)
+	newTime, newErr := time.Parse(time.RFC3339, newValue)
+	return oldErr == nil && newErr == nil && newTime.Before(oldTime) && !oldTime.After(time.Now())
+}
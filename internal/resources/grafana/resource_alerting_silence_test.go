@@ -0,0 +1,64 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccSilence_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var maintenance, now models.GettableSilence
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			alertingSilenceCheckExists.destroyed(&maintenance, nil),
+			alertingSilenceCheckExists.destroyed(&now, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_alerting_silence/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingSilenceCheckExists.exists("grafana_alerting_silence.maintenance", &maintenance),
+					alertingSilenceCheckExists.exists("grafana_alerting_silence.now", &now),
+					resource.TestMatchResourceAttr("grafana_alerting_silence.maintenance", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "state", "pending"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "starts_at", "2099-01-01T02:00:00Z"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "ends_at", "2099-01-01T04:00:00Z"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "created_by", "Terraform"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "matcher.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_alerting_silence.maintenance", "matcher.*", map[string]string{
+						"label": "severity",
+						"match": "!~",
+						"value": "critical|page",
+					}),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.now", "state", "active"),
+					resource.TestCheckResourceAttrSet("grafana_alerting_silence.now", "starts_at"),
+					resource.TestCheckResourceAttrSet("grafana_alerting_silence.now", "ends_at"),
+				),
+			},
+			{
+				ResourceName:      "grafana_alerting_silence.maintenance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Updating a duration-relative silence keeps its time window
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_alerting_silence/resource.tf", map[string]string{
+					"Investigating flapping alerts": "Still investigating flapping alerts",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					// The Alertmanager may replace the silence when updating it
+					alertingSilenceCheckExists.exists("grafana_alerting_silence.now", &now),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.now", "comment", "Still investigating flapping alerts"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.now", "state", "active"),
+				),
+			},
+		},
+	})
+}
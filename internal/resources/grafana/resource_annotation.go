@@ -2,6 +2,7 @@ package grafana
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -39,19 +40,21 @@ func resourceAnnotation() *common.Resource {
 			},
 
 			"time": {
-				Description:  "The RFC 3339-formatted time string indicating the annotation's time.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Description:      "The RFC 3339-formatted time string indicating the annotation's time.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentAnnotationTime,
 			},
 
 			"time_end": {
-				Description:  "The RFC 3339-formatted time string indicating the annotation's end time.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Description:      "The RFC 3339-formatted time string indicating the annotation's end time. Set it to create a region annotation. If not set, the annotation is a point in time and its end time is its time.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentAnnotationTime,
 			},
 
 			"dashboard_uid": {
@@ -89,13 +92,33 @@ func resourceAnnotation() *common.Resource {
 
 func listAnnotations(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
 	var ids []string
-	resp, err := client.Annotations.GetAnnotations(annotations.NewGetAnnotationsParams())
-	if err != nil {
-		return nil, err
-	}
+	seen := map[int64]bool{}
+	var limit int64 = 1000
+	params := annotations.NewGetAnnotationsParams().WithLimit(&limit)
+	for {
+		resp, err := client.Annotations.GetAnnotations(params)
+		if err != nil {
+			return nil, err
+		}
+
+		// The annotations API has no pages. The annotations are returned from the most recent to the oldest,
+		// so the next request gets the annotations up to the oldest time of this one.
+		newAnnotations := 0
+		for _, annotation := range resp.Payload {
+			if seen[annotation.ID] {
+				continue
+			}
+			seen[annotation.ID] = true
+			newAnnotations++
+			ids = append(ids, MakeOrgResourceID(orgID, annotation.ID))
+			if params.To == nil || annotation.Time < *params.To {
+				params.SetTo(common.Ref(annotation.Time))
+			}
+		}
 
-	for _, annotation := range resp.Payload {
-		ids = append(ids, MakeOrgResourceID(orgID, annotation.ID))
+		if int64(len(resp.Payload)) < limit || newAnnotations == 0 {
+			break
+		}
 	}
 
 	return ids, nil
@@ -134,8 +157,11 @@ func UpdateAnnotation(ctx context.Context, d *schema.ResourceData, meta interfac
 		TimeEnd: postAnnotation.TimeEnd,
 	}
 
-	_, err = client.Annotations.UpdateAnnotation(idStr, &annotation)
-	return diag.FromErr(err)
+	if _, err = client.Annotations.UpdateAnnotation(idStr, &annotation); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadAnnotation(ctx, d, meta)
 }
 
 func ReadAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -210,18 +236,31 @@ func makeAnnotation(d *schema.ResourceData) (*models.PostAnnotationsCmd, error)
 		a.Time = t
 	}
 
+	// The end time of a point annotation follows its time, instead of being kept from the state
 	timeEnd := d.Get("time_end").(string)
-	if timeEnd != "" {
+	if d.GetRawConfig().GetAttr("time_end").IsNull() {
+		a.TimeEnd = a.Time
+	} else if timeEnd != "" {
 		tEnd, err := millisSinceEpoch(timeEnd)
 		if err != nil {
 			return a, err
 		}
+		if tEnd < a.Time {
+			return a, fmt.Errorf("time_end (%s) must not be before time (%s)", timeEnd, start)
+		}
 		a.TimeEnd = tEnd
 	}
 
 	return a, err
 }
 
+// suppressEquivalentAnnotationTime ignores the differences between times formatted in different time zones.
+func suppressEquivalentAnnotationTime(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	oldTime, oldErr := time.Parse(time.RFC3339, oldValue)
+	newTime, newErr := time.Parse(time.RFC3339, newValue)
+	return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+}
+
 func millisSinceEpoch(timeStr string) (int64, error) {
 	t, err := time.Parse(
 		time.RFC3339,
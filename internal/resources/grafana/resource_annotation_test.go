@@ -3,12 +3,14 @@ package grafana_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var (
@@ -52,6 +54,62 @@ func TestAccAnnotation_basic(t *testing.T) {
 	})
 }
 
+func TestAccAnnotation_region(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0") // Annotations don't work right in OSS Grafana < 9.0.0
+
+	var annotation models.Annotation
+	text := acctest.RandomWithPrefix("Database maintenance")
+	regionConfig := testutils.TestAccExampleWithReplace(t, "resources/grafana_annotation/region.tf", map[string]string{
+		"Database maintenance": text,
+	})
+	pointConfig := testutils.TestAccExampleWithReplace(t, "resources/grafana_annotation/region.tf", map[string]string{
+		"Database maintenance":                   text,
+		`time_end = "2024-01-15T12:30:00+01:00"`: "",
+		"2024-01-15T10:00:00+01:00":              "2024-01-16T10:00:00+01:00",
+	})
+
+	checkDuration := func(expected time.Duration) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if got := time.Duration(annotation.TimeEnd-annotation.Time) * time.Millisecond; got != expected {
+				return fmt.Errorf("expected the annotation to last %s, got %s", expected, got)
+			}
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             annotationsCheckExists.destroyed(&annotation, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: regionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					annotationsCheckExists.exists("grafana_annotation.maintenance", &annotation),
+					checkDuration(150*time.Minute),
+				),
+			},
+			{
+				// The times returned by the API, in another time zone, don't cause a diff
+				Config:   regionConfig,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "grafana_annotation.maintenance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Without time_end, the annotation becomes a point in time
+				Config: pointConfig,
+				Check: resource.ComposeTestCheckFunc(
+					annotationsCheckExists.exists("grafana_annotation.maintenance", &annotation),
+					checkDuration(0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAnnotation_inOrg(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=9.0.0") // Annotations don't work right in OSS Grafana < 9.0.0
 
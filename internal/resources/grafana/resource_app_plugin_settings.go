@@ -0,0 +1,184 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+func resourceAppPluginSettings() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the settings of an installed app plugin in an organization: whether it is enabled and pinned, and its configuration.
+Deleting this resource disables the app plugin and removes its configuration.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/plugin-management/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/plugin/)
+
+The plugin must already be installed, for example with a ` + "`grafana_cloud_plugin_installation`" + ` resource in Grafana Cloud.
+`,
+
+		CreateContext: updateAppPluginSettings,
+		ReadContext:   readAppPluginSettings,
+		UpdateContext: updateAppPluginSettings,
+		DeleteContext: deleteAppPluginSettings,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the app plugin (eg. `grafana-oncall-app`).",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the app plugin is enabled in the organization.",
+			},
+			"pinned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the app plugin is pinned to the navigation menu.",
+			},
+			"json_data_encoded": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    normalizeAppPluginJSONData,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					if oldValue == "{}" && newValue == "" {
+						return true
+					}
+					return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
+				},
+				Description: "Serialized JSON string containing the configuration of the app plugin. The available options depend on the plugin.",
+			},
+			"secure_json_data_encoded": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    normalizeAppPluginJSONData,
+				Description: "Serialized JSON string containing the secure configuration of the app plugin, such as API tokens. " +
+					"It can't be read back from Grafana, so changes made outside of Terraform are not detected.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_app_plugin_settings",
+		orgResourceIDString("pluginID"),
+		schema,
+	)
+}
+
+type appPluginSettings struct {
+	ID             string                 `json:"id,omitempty"`
+	Type           string                 `json:"type,omitempty"`
+	Enabled        bool                   `json:"enabled"`
+	Pinned         bool                   `json:"pinned"`
+	JSONData       map[string]interface{} `json:"jsonData"`
+	SecureJSONData map[string]string      `json:"secureJsonData,omitempty"`
+}
+
+func readAppPluginSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, pluginID := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	var settings appPluginSettings
+	err := submitAPIRequest(ctx, client, "GetPluginSettingByID", http.MethodGet, appPluginSettingsPath(pluginID), nil, &settings)
+	if err, shouldReturn := common.CheckReadError("app plugin settings", d, err); shouldReturn {
+		return err
+	}
+	if settings.Type != "app" {
+		return diag.Errorf("plugin %q is a %s plugin, only the settings of app plugins can be managed", pluginID, settings.Type)
+	}
+
+	// An unconfigured plugin has no JSON data, which is the same as not setting it
+	jsonData := ""
+	if len(settings.JSONData) > 0 {
+		encoded, err := json.Marshal(settings.JSONData)
+		if err != nil {
+			return diag.Errorf("failed to marshal JSON data: %s", err)
+		}
+		jsonData = string(encoded)
+	}
+
+	d.Set("plugin_id", pluginID)
+	d.Set("enabled", settings.Enabled)
+	d.Set("pinned", settings.Pinned)
+	d.Set("json_data_encoded", jsonData)
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.SetId(MakeOrgResourceID(orgID, pluginID))
+
+	return nil
+}
+
+func updateAppPluginSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	pluginID := d.Get("plugin_id").(string)
+
+	settings := appPluginSettings{
+		Enabled:  d.Get("enabled").(bool),
+		Pinned:   d.Get("pinned").(bool),
+		JSONData: map[string]interface{}{},
+	}
+	if v := d.Get("json_data_encoded").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &settings.JSONData); err != nil {
+			return diag.Errorf("failed to unmarshal JSON data: %s", err)
+		}
+	}
+	if d.HasChange("secure_json_data_encoded") {
+		if v := d.Get("secure_json_data_encoded").(string); v != "" {
+			if err := json.Unmarshal([]byte(v), &settings.SecureJSONData); err != nil {
+				return diag.Errorf("failed to unmarshal secure JSON data: %s", err)
+			}
+		}
+	}
+
+	if err := submitAPIRequest(ctx, client, "UpdatePluginSetting", http.MethodPost, appPluginSettingsPath(pluginID), settings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update the settings of plugin %q: %w", pluginID, err))
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, pluginID))
+	return readAppPluginSettings(ctx, d, meta)
+}
+
+func deleteAppPluginSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, pluginID := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	settings := appPluginSettings{
+		Enabled:  false,
+		Pinned:   false,
+		JSONData: map[string]interface{}{},
+	}
+	err := submitAPIRequest(ctx, client, "UpdatePluginSetting", http.MethodPost, appPluginSettingsPath(pluginID), settings, nil)
+	diag, _ := common.CheckReadError("app plugin settings", d, err)
+	return diag
+}
+
+func appPluginSettingsPath(pluginID string) string {
+	return "/plugins/" + url.PathEscape(pluginID) + "/settings"
+}
+
+func normalizeAppPluginJSONData(v interface{}) string {
+	json, _ := structure.NormalizeJsonString(v)
+	return json
+}
@@ -0,0 +1,53 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccAppPluginSettings_basic(t *testing.T) {
+	// The Logs Drilldown app is preinstalled since Grafana 11.3
+	testutils.CheckOSSTestsEnabled(t, ">=11.3.0")
+
+	// The settings of a plugin are a singleton of the org
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppPluginSettings(true, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_app_plugin_settings.test", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "plugin_id", "grafana-lokiexplore-app"),
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "pinned", "false"),
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "json_data_encoded", `{"terraformTest":"first"}`),
+				),
+			},
+			{
+				Config: testAccAppPluginSettings(false, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("grafana_app_plugin_settings.test", "json_data_encoded", `{"terraformTest":"second"}`),
+				),
+			},
+			{
+				ResourceName:      "grafana_app_plugin_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAppPluginSettings(enabled bool, value string) string {
+	return fmt.Sprintf(`
+resource "grafana_app_plugin_settings" "test" {
+	plugin_id         = "grafana-lokiexplore-app"
+	enabled           = %t
+	json_data_encoded = jsonencode({ terraformTest = "%s" })
+}`, enabled, value)
+}
@@ -5,7 +5,10 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +29,8 @@ func resourceDashboard() *common.Resource {
 		Description: `
 Manages Grafana dashboards.
 
+Besides the UID, dashboards can be imported using their full URL (eg. ` + "`https://my-stack.grafana.net/d/my-uid/my-dashboard?orgId=2`" + `) or ` + "`<folderUID>/<dashboardUID>`" + `.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard/)
 `,
@@ -35,7 +40,7 @@ Manages Grafana dashboards.
 		UpdateContext: UpdateDashboard,
 		DeleteContext: DeleteDashboard,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importDashboard,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,11 +79,23 @@ Manages Grafana dashboards.
 				},
 			},
 			"config_json": {
-				Type:         schema.TypeString,
-				Required:     true,
-				StateFunc:    NormalizeDashboardConfigJSON,
-				ValidateFunc: validateDashboardConfigJSON,
-				Description:  "The complete dashboard model JSON.",
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        NormalizeDashboardConfigJSON,
+				ValidateFunc:     validateDashboardConfigJSON,
+				DiffSuppressFunc: suppressDashboardConfigJSONDiff,
+				Description:      "The complete dashboard model JSON.",
+			},
+			"ignore_json_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "JSONPath expressions of the fields of the dashboard model that are ignored when comparing `config_json` with the dashboard in Grafana. " +
+					"The root `$` is optional. Keys are separated by `.` or quoted within brackets, `[*]` matches all elements of an array and `[N]` matches the element at index N. " +
+					"For example: `$.panels[*].id`, `templating.list[0].current` or `$['templating']['list'][*]['current']`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateJSONPath,
+				},
 			},
 			"overwrite": {
 				Type:        schema.TypeBool,
@@ -108,13 +125,21 @@ func listDashboards(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int
 
 func listDashboardOrFolder(client *goapi.GrafanaHTTPAPI, orgID int64, searchType string) ([]string, error) {
 	uids := []string{}
-	resp, err := client.Search.Search(search.NewSearchParams().WithType(common.Ref(searchType)))
-	if err != nil {
-		return nil, err
-	}
+	var limit int64 = 1000
+	for page := int64(1); ; page++ {
+		params := search.NewSearchParams().WithType(common.Ref(searchType)).WithLimit(&limit).WithPage(&page)
+		resp, err := client.Search.Search(params)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, item := range resp.Payload {
-		uids = append(uids, MakeOrgResourceID(orgID, item.UID))
+		for _, item := range resp.Payload {
+			uids = append(uids, MakeOrgResourceID(orgID, item.UID))
+		}
+
+		if int64(len(resp.Payload)) < limit {
+			break
+		}
 	}
 
 	return uids, nil
@@ -135,6 +160,71 @@ func CreateDashboard(ctx context.Context, d *schema.ResourceData, meta interface
 	return ReadDashboard(ctx, d, meta)
 }
 
+// importDashboard resolves the import ID to the canonical <orgID>:<uid> ID.
+// On top of the canonical ID, dashboard URLs and [<orgID>:]<folderUID>/<dashboardUID> are accepted.
+func importDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	switch {
+	case strings.Contains(id, "://") || strings.HasPrefix(id, "/"):
+		var grafanaURL *url.URL
+		if metaClient, ok := meta.(*common.Client); ok {
+			grafanaURL = metaClient.GrafanaAPIURLParsed
+		}
+		resolvedID, err := DashboardImportIDFromURL(id, grafanaURL)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(resolvedID)
+	case strings.Contains(id, "/"):
+		client, orgID, folderAndDashboardUID := OAPIClientFromExistingOrgResource(meta, id)
+		folderUID, dashboardUID, _ := strings.Cut(folderAndDashboardUID, "/")
+		resp, err := client.Dashboards.GetDashboardByUID(dashboardUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dashboard %q: %w", dashboardUID, err)
+		}
+		if resp.Payload.Meta.FolderUID != folderUID {
+			return nil, fmt.Errorf("dashboard %q is in folder %q, not %q", dashboardUID, resp.Payload.Meta.FolderUID, folderUID)
+		}
+		d.SetId(MakeOrgResourceID(orgID, dashboardUID))
+	}
+
+	return schema.ImportStatePassthroughContext(ctx, d, meta)
+}
+
+// DashboardImportIDFromURL returns the import ID of the dashboard at the given URL (eg. https://my-stack.grafana.net/d/my-uid/my-dashboard?orgId=2)
+// If the URL has a host, it must match the host of the configured Grafana URL, so that dashboards aren't imported from the wrong instance.
+func DashboardImportIDFromURL(rawURL string, grafanaURL *url.URL) (string, error) {
+	dashboardURL, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid dashboard URL %q: %w", rawURL, err)
+	}
+	if dashboardURL.Host != "" && grafanaURL != nil && grafanaURL.Host != "" && !strings.EqualFold(dashboardURL.Host, grafanaURL.Host) {
+		return "", fmt.Errorf("dashboard URL host %q does not match the Grafana URL host %q", dashboardURL.Host, grafanaURL.Host)
+	}
+
+	var uid string
+	segments := strings.Split(strings.Trim(dashboardURL.Path, "/"), "/")
+	for i, segment := range segments {
+		if (segment == "d" || segment == "d-solo") && i+1 < len(segments) {
+			uid = segments[i+1]
+			break
+		}
+	}
+	if uid == "" {
+		return "", fmt.Errorf("no dashboard UID found in URL %q, expected a path like /d/<uid>/<slug>", rawURL)
+	}
+
+	orgIDStr := dashboardURL.Query().Get("orgId")
+	if orgIDStr == "" {
+		return uid, nil
+	}
+	orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid orgId %q in URL %q: %w", orgIDStr, rawURL, err)
+	}
+	return MakeOrgResourceID(orgID, uid), nil
+}
+
 func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
 	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
@@ -251,6 +341,46 @@ func validateDashboardConfigJSON(config interface{}, k string) ([]string, []erro
 	return nil, nil
 }
 
+// suppressDashboardConfigJSONDiff is the DiffSuppressFunc for `config_json`.
+// It ignores the configured `ignore_json_paths` and null fields.
+func suppressDashboardConfigJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	ignorePaths := common.ListToStringSlice(d.Get("ignore_json_paths").([]interface{}))
+	return DashboardConfigJSONEquivalent(old, new, ignorePaths)
+}
+
+// DashboardConfigJSONEquivalent returns true if both dashboard models are the same,
+// once normalized and stripped of null fields and of the given paths.
+func DashboardConfigJSONEquivalent(old, new string, ignorePaths []string) bool {
+	if old == new {
+		return true
+	}
+	// Hashes can only be compared as is
+	if common.SHA256Regexp.MatchString(old) || common.SHA256Regexp.MatchString(new) {
+		return false
+	}
+
+	semanticDashboard := func(config string) (interface{}, error) {
+		dashboardJSON, err := UnmarshalDashboardConfigJSON(config)
+		if err != nil {
+			return nil, err
+		}
+		var normalized interface{}
+		if err := json.Unmarshal([]byte(normalizeDashboardConfigJSON(dashboardJSON)), &normalized); err != nil {
+			return nil, err
+		}
+		return common.RemoveJSONPaths(common.RemoveJSONNulls(normalized), ignorePaths)
+	}
+	oldDashboard, err := semanticDashboard(old)
+	if err != nil {
+		return false
+	}
+	newDashboard, err := semanticDashboard(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDashboard, newDashboard)
+}
+
 // NormalizeDashboardConfigJSON is the StateFunc for the `config_json` field.
 //
 // It removes the following fields:
@@ -272,6 +402,17 @@ func NormalizeDashboardConfigJSON(config interface{}) string {
 		}
 	}
 
+	j := normalizeDashboardConfigJSON(dashboardJSON)
+
+	if StoreDashboardSHA256 {
+		configHash := sha256.Sum256([]byte(j))
+		return fmt.Sprintf("%x", configHash[:])
+	} else {
+		return j
+	}
+}
+
+func normalizeDashboardConfigJSON(dashboardJSON map[string]interface{}) string {
 	delete(dashboardJSON, "id")
 	delete(dashboardJSON, "version")
 
@@ -293,11 +434,5 @@ func NormalizeDashboardConfigJSON(config interface{}) string {
 	}
 
 	j, _ := json.Marshal(dashboardJSON)
-
-	if StoreDashboardSHA256 {
-		configHash := sha256.Sum256(j)
-		return fmt.Sprintf("%x", configHash[:])
-	} else {
-		return string(j)
-	}
+	return string(j)
 }
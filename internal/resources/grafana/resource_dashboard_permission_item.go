@@ -135,6 +135,10 @@ func (r *resourceDashboardPermissionItem) Read(ctx context.Context, req resource
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if diags := r.keepTargetReference(data.ToBase(), readData); diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
 	data.SetFromBase(readData)
 
 	// Save data into Terraform state
@@ -0,0 +1,339 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/grafana/grafana-openapi-client-go/client/snapshots"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	resourceDashboardSnapshotName = "grafana_dashboard_snapshot"
+	resourceDashboardSnapshotID   = orgResourceIDString("key")
+
+	// Check interface
+	_ resource.ResourceWithImportState = (*resourceDashboardSnapshot)(nil)
+)
+
+func makeResourceDashboardSnapshot() *common.Resource {
+	return common.NewResource(
+		common.CategoryGrafanaOSS,
+		resourceDashboardSnapshotName,
+		resourceDashboardSnapshotID,
+		&resourceDashboardSnapshot{},
+	)
+}
+
+type resourceDashboardSnapshotModel struct {
+	ID         types.String `tfsdk:"id"`
+	OrgID      types.String `tfsdk:"org_id"`
+	ConfigJSON types.String `tfsdk:"config_json"`
+	Name       types.String `tfsdk:"name"`
+	Expires    types.Int64  `tfsdk:"expires"`
+	External   types.Bool   `tfsdk:"external"`
+	Key        types.String `tfsdk:"key"`
+	DeleteKey  types.String `tfsdk:"delete_key"`
+	URL        types.String `tfsdk:"url"`
+	DeleteURL  types.String `tfsdk:"delete_url"`
+}
+
+type resourceDashboardSnapshot struct {
+	basePluginFrameworkResource
+}
+
+func (r *resourceDashboardSnapshot) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = resourceDashboardSnapshotName
+}
+
+func (r *resourceDashboardSnapshot) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a dashboard snapshot. Snapshots cannot be modified, so any change recreates the snapshot.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/share-dashboards-panels/#publish-a-snapshot)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/snapshot/)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": pluginFrameworkOrgIDAttribute(),
+			"config_json": schema.StringAttribute{
+				Required:    true,
+				Description: "The complete dashboard model JSON, including the panel data to include in the snapshot.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the snapshot. Defaults to the title of the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "When the snapshot should expire, in seconds from its creation. The default (0) means that the snapshot never expires. Expired snapshots are removed from the state.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"external": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Save the snapshot on the external server rather than locally. The external server must be configured in Grafana.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the snapshot, used to access it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to delete the snapshot. This is only known for snapshots created by Terraform, it is not set on import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the snapshot.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL which can be used to delete the snapshot without authentication.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDashboardSnapshot) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data, diags := r.read(ctx, resourceDashboardSnapshotModel{ID: types.StringValue(req.ID)})
+	if diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError("Resource not found", "Resource not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *resourceDashboardSnapshot) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data resourceDashboardSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get client", err.Error())
+		return
+	}
+
+	dashboardJSON, err := UnmarshalDashboardConfigJSON(data.ConfigJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config_json"), "Invalid dashboard JSON", err.Error())
+		return
+	}
+	name := data.Name.ValueString()
+	if name == "" {
+		name, _ = dashboardJSON["title"].(string)
+	}
+
+	createResp, err := client.Snapshots.CreateDashboardSnapshot(nil, withDashboardSnapshotBody(map[string]any{
+		"dashboard": dashboardJSON,
+		"name":      name,
+		"expires":   data.Expires.ValueInt64(),
+		"external":  data.External.ValueBool(),
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create dashboard snapshot", err.Error())
+		return
+	}
+	snapshot := createResp.Payload
+
+	data.ID = types.StringValue(resourceDashboardSnapshotID.Make(orgID, snapshot.Key))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+	data.Name = types.StringValue(name)
+	data.Key = types.StringValue(snapshot.Key)
+	data.DeleteKey = types.StringValue(snapshot.DeleteKey)
+	data.URL = types.StringValue(snapshot.URL)
+	data.DeleteURL = types.StringValue(snapshot.DeleteURL)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *resourceDashboardSnapshot) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data resourceDashboardSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read from API
+	readData, diags := r.read(ctx, data)
+	if diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
+	if readData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, readData)...)
+}
+
+func (r *resourceDashboardSnapshot) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update shouldn't happen as all attributes require replacement
+	resp.Diagnostics.AddError("Update not supported", "Update not supported")
+}
+
+func (r *resourceDashboardSnapshot) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data resourceDashboardSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, _, idFields, err := r.clientFromExistingOrgResource(resourceDashboardSnapshotID, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get client", err.Error())
+		return
+	}
+
+	// The delete key is required for external snapshots. It is unknown for imported snapshots, which can only be deleted by key.
+	if deleteKey := data.DeleteKey.ValueString(); deleteKey != "" {
+		_, err = client.Snapshots.DeleteDashboardSnapshotByDeleteKey(deleteKey)
+	} else {
+		_, err = client.Snapshots.DeleteDashboardSnapshot(idFields[0].(string))
+	}
+	if err != nil && !common.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to delete dashboard snapshot", err.Error())
+	}
+}
+
+// read finds the snapshot with the given key. The snapshot API doesn't return the model of a snapshot,
+// so the attributes that can't be read back are taken from the given model.
+func (r *resourceDashboardSnapshot) read(ctx context.Context, data resourceDashboardSnapshotModel) (*resourceDashboardSnapshotModel, diag.Diagnostics) {
+	client, orgID, idFields, err := r.clientFromExistingOrgResource(resourceDashboardSnapshotID, data.ID.ValueString())
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get client", err.Error())}
+	}
+	key := idFields[0].(string)
+
+	params := snapshots.NewSearchDashboardSnapshotsParams().WithLimit(common.Ref(int64(1000)))
+	if name := data.Name.ValueString(); name != "" {
+		params.SetQuery(&name)
+	}
+	searchResp, err := client.Snapshots.SearchDashboardSnapshots(params)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to search dashboard snapshots", err.Error())}
+	}
+	var snapshot *models.DashboardSnapshotDTO
+	for _, s := range searchResp.Payload {
+		if s.Key == key {
+			snapshot = s
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	data.ID = types.StringValue(resourceDashboardSnapshotID.Make(orgID, key))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+	data.Name = types.StringValue(snapshot.Name)
+	data.External = types.BoolValue(snapshot.External)
+	data.Key = types.StringValue(key)
+	if data.Expires.IsNull() {
+		data.Expires = types.Int64Value(0)
+	}
+	if data.URL.IsNull() {
+		data.URL = types.StringValue(r.snapshotURL(key, snapshot))
+	}
+	return &data, nil
+}
+
+func (r *resourceDashboardSnapshot) snapshotURL(key string, snapshot *models.DashboardSnapshotDTO) string {
+	if snapshot.External {
+		return snapshot.ExternalURL
+	}
+	u := url.URL{
+		Scheme: "https",
+		Host:   r.config.Host,
+		Path:   strings.TrimSuffix(r.config.BasePath, "/api") + "/dashboard/snapshot/" + key,
+	}
+	if len(r.config.Schemes) > 0 {
+		u.Scheme = r.config.Schemes[0]
+	}
+	return u.String()
+}
+
+// withDashboardSnapshotBody replaces the request body of the snapshot creation.
+// The generated client wraps the dashboard model in an `Object` key, which Grafana doesn't expect.
+func withDashboardSnapshotBody(body map[string]any) snapshots.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		op.Params = runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+			b, err := json.Marshal(body)
+			if err != nil {
+				return err
+			}
+			return req.SetBodyParam(json.RawMessage(b))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDashboardSnapshot_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var snapshot models.DashboardSnapshotDTO
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardSnapshotCheckExists.destroyed(&snapshot, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_dashboard_snapshot/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardSnapshotCheckExists.exists("grafana_dashboard_snapshot.incident", &snapshot),
+					resource.TestMatchResourceAttr("grafana_dashboard_snapshot.incident", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.incident", "org_id", "1"),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.incident", "name", "Incident snapshot"),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.incident", "expires", "86400"),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.incident", "external", "false"),
+					resource.TestCheckResourceAttrSet("grafana_dashboard_snapshot.incident", "key"),
+					resource.TestCheckResourceAttrSet("grafana_dashboard_snapshot.incident", "delete_key"),
+					resource.TestCheckResourceAttrSet("grafana_dashboard_snapshot.incident", "url"),
+				),
+			},
+			{
+				ResourceName:            "grafana_dashboard_snapshot.incident",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config_json", "delete_key", "delete_url", "expires"},
+			},
+			// Changing the model recreates the snapshot
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_dashboard_snapshot/resource.tf", map[string]string{
+					"Incident snapshot": "Updated incident snapshot",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardSnapshotCheckExists.destroyed(&snapshot, nil),
+					dashboardSnapshotCheckExists.exists("grafana_dashboard_snapshot.incident", &snapshot),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.incident", "name", "Updated incident snapshot"),
+				),
+			},
+		},
+	})
+}
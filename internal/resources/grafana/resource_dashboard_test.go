@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -88,6 +89,14 @@ func TestAccDashboard_basic(t *testing.T) {
 						ImportStateVerify:       true,
 						ImportStateVerifyIgnore: []string{"message"},
 					},
+					{
+						// Importing from the dashboard URL matches the state of the previous step.
+						ResourceName:            "grafana_dashboard.test",
+						ImportState:             true,
+						ImportStateId:           strings.TrimRight(os.Getenv("GRAFANA_URL"), "/") + "/d/basic-update/updated-title?orgId=1",
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"message"},
+					},
 				},
 			})
 		})
@@ -159,6 +168,33 @@ func TestAccDashboard_computed_config(t *testing.T) {
 	})
 }
 
+func TestAccDashboard_ignoreJSONPaths(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardIgnoreJSONPaths(uid, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "ignore_json_paths.#", "1"),
+				),
+			},
+			{
+				// Changing an ignored path doesn't produce a diff
+				Config:             testAccDashboardIgnoreJSONPaths(uid, "second"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func TestAccDashboard_folder_uid(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=8.0.0") // UID in folders were added in v8
 
@@ -202,6 +238,12 @@ func TestAccDashboard_folder_uid(t *testing.T) {
 				ResourceName:      "grafana_dashboard.test_folder",
 				ImportStateVerify: true,
 			},
+			{
+				ImportState:       true,
+				ResourceName:      "grafana_dashboard.test_folder",
+				ImportStateId:     uid + "-2/" + uid, // <folder uid>/<dashboard uid>
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -315,6 +357,176 @@ func Test_NormalizeDashboardConfigJSON(t *testing.T) {
 	}
 }
 
+func Test_DashboardConfigJSONEquivalent(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	tests := []struct {
+		name        string
+		old         string
+		new         string
+		ignorePaths []string
+		want        bool
+	}{
+		{
+			name: "Key order is ignored",
+			old:  `{"title":"test","uid":"test"}`,
+			new:  `{"uid":"test","title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Version and ids are ignored",
+			old:  `{"id":1,"panels":[{"id":2,"type":"text"}],"title":"test","version":3}`,
+			new:  `{"panels":[{"type":"text"}],"title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Null fields are ignored",
+			old:  `{"description":null,"panels":[{"type":"text","options":null}],"title":"test"}`,
+			new:  `{"panels":[{"type":"text"}],"title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Changed fields are not ignored",
+			old:  `{"title":"test"}`,
+			new:  `{"title":"other"}`,
+			want: false,
+		},
+		{
+			name:        "Wildcard paths are ignored",
+			old:         `{"templating":{"list":[{"name":"a","current":{"value":"1"}},{"name":"b","current":{"value":"2"}}]},"title":"test"}`,
+			new:         `{"templating":{"list":[{"name":"a"},{"name":"b","current":{"value":"3"}}]},"title":"test"}`,
+			ignorePaths: []string{"templating.list[*].current"},
+			want:        true,
+		},
+		{
+			name:        "Nested panel ids are ignored",
+			old:         `{"panels":[{"type":"row","panels":[{"id":5,"type":"text"}]}],"title":"test"}`,
+			new:         `{"panels":[{"type":"row","panels":[{"id":6,"type":"text"}]}],"title":"test"}`,
+			ignorePaths: []string{"panels[*].panels[*].id"},
+			want:        true,
+		},
+		{
+			name:        "Indexed paths only ignore the given element",
+			old:         `{"panels":[{"title":"a"},{"title":"b"}],"title":"test"}`,
+			new:         `{"panels":[{"title":"c"},{"title":"d"}],"title":"test"}`,
+			ignorePaths: []string{"panels[0].title"},
+			want:        false,
+		},
+		{
+			name:        "JSONPath root and bracket-quoted keys are accepted",
+			old:         `{"templating":{"list":[{"name":"a","current":{"value":"1"}}]},"title":"test"}`,
+			new:         `{"templating":{"list":[{"name":"a","current":{"value":"2"}}]},"title":"test"}`,
+			ignorePaths: []string{"$['templating']['list'][*]['current']"},
+			want:        true,
+		},
+		{
+			name:        "Index 0 is the first element",
+			old:         `{"panels":[{"title":"a"},{"title":"b"}],"title":"test"}`,
+			new:         `{"panels":[{"title":"c"},{"title":"b"}],"title":"test"}`,
+			ignorePaths: []string{"panels[0].title"},
+			want:        true,
+		},
+		{
+			name:        "Negative indexes are invalid",
+			old:         `{"panels":[{"title":"a"},{"title":"b"}],"title":"test"}`,
+			new:         `{"panels":[{"title":"a"},{"title":"c"}],"title":"test"}`,
+			ignorePaths: []string{"panels[-1].title"},
+			want:        false,
+		},
+		{
+			name: "Hashes are compared as is",
+			old:  "fadbc115a19bfd7962d8f8d749d22c20d0a44043d390048bf94b698776d9f7f1",
+			new:  `{"title":"Terraform Acceptance Test","uid":"basic"}`,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grafana.DashboardConfigJSONEquivalent(tt.old, tt.new, tt.ignorePaths); got != tt.want {
+				t.Errorf("DashboardConfigJSONEquivalent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_DashboardImportIDFromURL(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	grafanaURL, _ := url.Parse("https://my-stack.grafana.net")
+	tests := []struct {
+		name    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Full URL with org",
+			url:  "https://my-stack.grafana.net/d/my-uid/my-dashboard?orgId=2&from=now-1h",
+			want: "2:my-uid",
+		},
+		{
+			name: "URL without org",
+			url:  "https://my-stack.grafana.net/d/my-uid",
+			want: "my-uid",
+		},
+		{
+			name: "URL with subpath and solo panel",
+			url:  "https://my-stack.grafana.net/grafana/d-solo/my-uid/my-dashboard?orgId=1&panelId=2",
+			want: "1:my-uid",
+		},
+		{
+			name: "Relative URL",
+			url:  "/d/my-uid/my-dashboard",
+			want: "my-uid",
+		},
+		{
+			name:    "Other stack",
+			url:     "https://other-stack.grafana.net/d/my-uid/my-dashboard",
+			wantErr: true,
+		},
+		{
+			name:    "Not a dashboard URL",
+			url:     "https://my-stack.grafana.net/dashboards/f/my-folder",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid org",
+			url:     "https://my-stack.grafana.net/d/my-uid?orgId=abc",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := grafana.DashboardImportIDFromURL(tt.url, grafanaURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DashboardImportIDFromURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DashboardImportIDFromURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testAccDashboardIgnoreJSONPaths(uid string, currentValue string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	ignore_json_paths = ["$.templating.list[*].current"]
+	config_json = jsonencode({
+		title = "%[1]s"
+		uid   = "%[1]s"
+		templating = {
+			list = [{
+				name    = "var"
+				type    = "custom"
+				query   = "first,second"
+				current = { text = "%[2]s", value = "%[2]s" }
+			}]
+		}
+	})
+}`, uid, currentValue)
+}
+
 func testAccDashboardFolder(uid string, folderRef string) string {
 	return fmt.Sprintf(`
 resource "grafana_folder" "test_folder1" {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/datasources"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 )
@@ -27,6 +28,8 @@ func resourceDataSource() *common.Resource {
 
 The required arguments for this resource vary depending on the type of data
 source selected (via the 'type' argument).
+
+Besides the UID, data sources can be imported using their name (eg. ` + "`name:Prometheus`" + ` or ` + "`<orgID>:name:Prometheus`" + `).
 `,
 
 		CreateContext: CreateDataSource,
@@ -36,7 +39,7 @@ source selected (via the 'type' argument).
 		SchemaVersion: 1,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: importOrgResourceByName(getDataSourceUIDByName, func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
 
 				resp, err := client.Datasources.GetDataSourceByUID(idStr)
@@ -48,8 +51,13 @@ source selected (via the 'type' argument).
 					return nil, fmt.Errorf("this Grafana data source is read-only. It cannot be imported as a resource. Use the `data_grafana_data_source` data source instead")
 				}
 
+				// These attributes are not read from Grafana, set their default values
+				for _, key := range []string{"secure_json_data_drift_detection", "validate", "validate_warn_only"} {
+					d.Set(key, false)
+				}
+
 				return schema.ImportStatePassthroughContext(ctx, d, meta)
-			},
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,6 +127,31 @@ source selected (via the 'type' argument).
 			},
 			"json_data_encoded":        datasourceJSONDataAttribute(),
 			"secure_json_data_encoded": datasourceSecureJSONDataAttribute(),
+			"secure_json_data_drift_detection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "The secure JSON data can't be read back from Grafana, so changes made outside of Terraform are not detected by default. " +
+					"If enabled, the version of the data source is stored on each apply, and the secure JSON data is sent again if the data source was changed outside of Terraform since then, or if some of its secure fields were removed. " +
+					"Changes made by other resources, such as `grafana_data_source_config`, are also detected as drift, so this shouldn't be enabled along with them.",
+			},
+			"validate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to check the health of the data source (eg. its connectivity and authentication) after creating or updating it, and fail the apply if it's not healthy. Not all data source types support health checks.",
+			},
+			"validate_warn_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `validate` is enabled, emit a warning instead of failing the apply when the data source is not healthy.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the data source when it was last applied. Grafana increments it on each change of the data source.",
+			},
 		},
 	}
 
@@ -227,7 +260,12 @@ func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(MakeOrgResourceID(orgID, resp.Payload.Datasource.UID))
-	return ReadDataSource(ctx, d, meta)
+	d.Set("version", resp.Payload.Datasource.Version)
+	if diags := ReadDataSource(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	return checkDataSourceHealth(client, d)
 }
 
 // UpdateDataSource updates a Grafana datasource
@@ -253,9 +291,44 @@ func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 		User:            dataSource.User,
 		WithCredentials: dataSource.WithCredentials,
 	}
-	_, err = client.Datasources.UpdateDataSourceByUID(idStr, &body)
+	if _, err = client.Datasources.UpdateDataSourceByUID(idStr, &body); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Store the version resulting from the update, for the drift detection of the secure JSON data
+	resp, err := client.Datasources.GetDataSourceByUID(idStr)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("version", resp.Payload.Version)
 
-	return diag.FromErr(err)
+	return checkDataSourceHealth(client, d)
+}
+
+// checkDataSourceHealth checks the health of the data source if the `validate` attribute is set
+func checkDataSourceHealth(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData) diag.Diagnostics {
+	if !d.Get("validate").(bool) {
+		return nil
+	}
+
+	severity := diag.Error
+	if d.Get("validate_warn_only").(bool) {
+		severity = diag.Warning
+	}
+
+	_, err := client.Datasources.CheckDatasourceHealthWithUID(d.Get("uid").(string))
+	if err == nil {
+		return nil
+	}
+	detail := err.Error()
+	if badRequest, ok := err.(*datasources.CheckDatasourceHealthWithUIDBadRequest); ok && badRequest.Payload != nil && badRequest.Payload.Message != nil {
+		detail = *badRequest.Payload.Message
+	}
+	return diag.Diagnostics{{
+		Severity: severity,
+		Summary:  fmt.Sprintf("Data source %q is not healthy", d.Get("name").(string)),
+		Detail:   detail,
+	}}
 }
 
 // ReadDataSource reads a Grafana datasource
@@ -267,6 +340,15 @@ func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	// The version is only updated when applying (or when importing), so that out-of-band changes can be detected
+	if d.Get("version").(int) == 0 {
+		d.Set("version", resp.Payload.Version)
+	}
+	if d.Get("secure_json_data_drift_detection").(bool) && secureJSONDataDrifted(d, resp.Payload) {
+		// The secret values are unknown, so they are removed from the state, which shows up as a diff if they are configured
+		d.Set("secure_json_data_encoded", "{}")
+	}
+
 	return datasourceToState(d, resp.Payload)
 }
 
@@ -297,6 +379,25 @@ func datasourceToState(d *schema.ResourceData, dataSource *models.DataSource) di
 	return datasourceConfigToState(d, dataSource)
 }
 
+// secureJSONDataDrifted returns whether the data source was changed since it was last applied,
+// or whether some of the secure fields in the state are no longer set in Grafana.
+func secureJSONDataDrifted(d *schema.ResourceData, dataSource *models.DataSource) bool {
+	if int64(d.Get("version").(int)) != dataSource.Version {
+		return true
+	}
+
+	secureJSONData, err := makeSecureJSONData(d)
+	if err != nil {
+		return true
+	}
+	for key := range secureJSONData {
+		if !dataSource.SecureJSONFields[key] {
+			return true
+		}
+	}
+	return false
+}
+
 func datasourceConfigToState(d *schema.ResourceData, dataSource *models.DataSource) diag.Diagnostics {
 	gottenJSONData, gottenHeaders := removeHeadersFromJSONData(dataSource.JSONData.(map[string]interface{}))
 	encodedJSONData, err := json.Marshal(gottenJSONData)
@@ -419,3 +520,11 @@ func removeHeadersFromJSONData(input map[string]interface{}) (map[string]interfa
 
 	return jsonData, headers
 }
+
+func getDataSourceUIDByName(client *goapi.GrafanaHTTPAPI, name string) (string, error) {
+	resp, err := client.Datasources.GetDataSourceByName(name)
+	if err != nil {
+		return "", err
+	}
+	return resp.Payload.UID, nil
+}
@@ -0,0 +1,338 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+// The data source types that support LBAC rules
+var lbacRulesDataSourceTypes = map[string]bool{
+	"loki":       true,
+	"prometheus": true,
+	"tempo":      true,
+}
+
+var lbacMatcherRegexp = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_.]*)\s*(=~|!~|!=|=)\s*("(?:[^"\\]|\\.)*")\s*$`)
+
+func resourceDataSourceConfigLBACRules() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the LBAC (label-based access control) rules of a data source, which restrict the data that the members of each team can query.
+This resource manages all the rules of the data source: rules of the teams that aren't configured are removed.
+
+The supported data sources are Loki, Prometheus (Mimir) and Tempo. Each rule is a label selector (eg. ` + "`{ namespace=\"prod\", app=~\"api-.*\" }`" + `), which is validated at plan time.
+The labels are checked against the type of the data source at plan time if its UID is known, otherwise when applying.
+For Tempo, the labels are trace attributes (eg. ` + "`{ resource.service.name=\"checkout\" }`" + `).
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/connect-externally-hosted/data-sources/loki/lbac-for-data-sources/)
+
+This resource requires Grafana Cloud or Grafana Enterprise 11.0.0 or later, with the LBAC feature enabled for the data source type (feature toggles ` + "`teamHttpHeaders`, `teamHttpHeadersMimir` and `teamHttpHeadersTempo`" + `).
+`,
+
+		CreateContext: updateDataSourceConfigLBACRules,
+		ReadContext:   readDataSourceConfigLBACRules,
+		UpdateContext: updateDataSourceConfigLBACRules,
+		DeleteContext: deleteDataSourceConfigLBACRules,
+		CustomizeDiff: dataSourceConfigLBACRulesCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"datasource_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the data source.",
+			},
+			"team": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The LBAC rules of a team.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the team.",
+						},
+						"rules": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The label selectors that the data queried by the members of the team must match. The data matching any of the selectors is returned.",
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validateLBACSelector,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaEnterprise,
+		"grafana_data_source_config_lbac_rules",
+		orgResourceIDString("datasourceUID"),
+		schema,
+	)
+}
+
+type lbacTeamRules struct {
+	TeamID  string   `json:"teamId"`
+	TeamUID string   `json:"teamUid"`
+	Rules   []string `json:"rules"`
+}
+
+type lbacRules struct {
+	Rules []lbacTeamRules `json:"rules"`
+}
+
+func readDataSourceConfigLBACRules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	var rules lbacRules
+	err := submitAPIRequest(ctx, client, "GetTeamLBACRulesApi", http.MethodGet, lbacRulesPath(uid), nil, &rules)
+	if err, shouldReturn := common.CheckReadError("data source LBAC rules", d, err); shouldReturn {
+		return err
+	}
+
+	// Keep the team IDs in the format they are configured with (with or without the org ID)
+	configuredTeamIDs := map[string]string{}
+	for _, raw := range d.Get("team").(*schema.Set).List() {
+		teamID := raw.(map[string]interface{})["team_id"].(string)
+		_, id := SplitOrgResourceID(teamID)
+		configuredTeamIDs[id] = teamID
+	}
+
+	teams := make([]interface{}, 0, len(rules.Rules))
+	for _, teamRules := range rules.Rules {
+		if len(teamRules.Rules) == 0 {
+			continue
+		}
+		teamID, ok := configuredTeamIDs[teamRules.TeamID]
+		if !ok {
+			teamID = MakeOrgResourceID(orgID, teamRules.TeamID)
+		}
+		teams = append(teams, map[string]interface{}{
+			"team_id": teamID,
+			"rules":   teamRules.Rules,
+		})
+	}
+
+	d.Set("team", teams)
+	d.Set("datasource_uid", uid)
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.SetId(MakeOrgResourceID(orgID, uid))
+
+	return nil
+}
+
+func updateDataSourceConfigLBACRules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	uid := d.Get("datasource_uid").(string)
+
+	resp, err := client.Datasources.GetDataSourceByUID(uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dsType := resp.Payload.Type
+	if err := checkLBACRulesDataSourceType(dsType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules := lbacRules{Rules: []lbacTeamRules{}}
+	for _, raw := range d.Get("team").(*schema.Set).List() {
+		team := raw.(map[string]interface{})
+		teamRules, err := buildLBACTeamRules(client, dsType, team["team_id"].(string), common.ListToStringSlice(team["rules"].([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Rules = append(rules.Rules, teamRules)
+	}
+
+	if err := submitAPIRequest(ctx, client, "UpdateTeamLBACRulesApi", http.MethodPut, lbacRulesPath(uid), rules, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, uid))
+	return readDataSourceConfigLBACRules(ctx, d, meta)
+}
+
+func deleteDataSourceConfigLBACRules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	err := submitAPIRequest(ctx, client, "UpdateTeamLBACRulesApi", http.MethodPut, lbacRulesPath(uid), lbacRules{Rules: []lbacTeamRules{}}, nil)
+	diag, _ := common.CheckReadError("data source LBAC rules", d, err)
+	return diag
+}
+
+func buildLBACTeamRules(client *goapi.GrafanaHTTPAPI, dsType, teamIDStr string, rules []string) (lbacTeamRules, error) {
+	_, teamIDStr = SplitOrgResourceID(teamIDStr)
+	teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+	if err != nil {
+		return lbacTeamRules{}, fmt.Errorf("invalid team ID %q: %w", teamIDStr, err)
+	}
+	team, err := getTeamByID(client, teamID)
+	if err != nil {
+		return lbacTeamRules{}, fmt.Errorf("error getting team %d: %w", teamID, err)
+	}
+
+	if err := checkLBACRulesForType(dsType, rules); err != nil {
+		return lbacTeamRules{}, fmt.Errorf("invalid rule for team %d: %w", teamID, err)
+	}
+
+	return lbacTeamRules{
+		TeamID:  teamIDStr,
+		TeamUID: team.UID,
+		Rules:   rules,
+	}, nil
+}
+
+// dataSourceConfigLBACRulesCustomizeDiff checks the rules against the type of the data source, if its UID is known at plan time.
+func dataSourceConfigLBACRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	uid := d.Get("datasource_uid").(string)
+	if !d.NewValueKnown("datasource_uid") || !d.NewValueKnown("org_id") || uid == "" {
+		return nil
+	}
+	metaClient, ok := meta.(*common.Client)
+	if !ok || metaClient.GrafanaAPI == nil {
+		return nil
+	}
+	client := metaClient.GrafanaAPI.Clone()
+	if orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64); orgID > 0 {
+		client = client.WithOrgID(orgID)
+	}
+	// The data source may not exist yet (eg. if it is replaced in the same apply), it is then checked when applying
+	resp, err := client.Datasources.GetDataSourceByUID(uid)
+	if err != nil {
+		return nil
+	}
+	dsType := resp.Payload.Type
+	if err := checkLBACRulesDataSourceType(dsType); err != nil {
+		return err
+	}
+
+	// The rules are read from the config, since the team IDs may be unknown
+	teams := d.GetRawConfig().GetAttr("team")
+	if teams.IsNull() || !teams.IsKnown() {
+		return nil
+	}
+	for it := teams.ElementIterator(); it.Next(); {
+		_, team := it.Element()
+		rules := team.GetAttr("rules")
+		if rules.IsNull() || !rules.IsKnown() {
+			continue
+		}
+		for it := rules.ElementIterator(); it.Next(); {
+			_, rule := it.Element()
+			if rule.IsNull() || !rule.IsKnown() {
+				continue
+			}
+			if err := checkLBACRulesForType(dsType, []string{rule.AsString()}); err != nil {
+				return fmt.Errorf("invalid rule: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkLBACRulesDataSourceType(dsType string) error {
+	if !lbacRulesDataSourceTypes[dsType] {
+		return fmt.Errorf("LBAC rules are not supported for data sources of type %q, the supported types are loki, prometheus and tempo", dsType)
+	}
+	return nil
+}
+
+// checkLBACRulesForType checks the rules against the type of the data source.
+// Only the trace attributes of Tempo can contain dots, which are not valid in Loki and Prometheus labels.
+func checkLBACRulesForType(dsType string, rules []string) error {
+	for _, rule := range rules {
+		if err := checkLBACSelector(rule, dsType == "tempo"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lbacRulesPath(uid string) string {
+	return "/datasources/uid/" + uid + "/lbac/teams"
+}
+
+func validateLBACSelector(i interface{}, _ cty.Path) diag.Diagnostics {
+	return diag.FromErr(checkLBACSelector(i.(string), true))
+}
+
+// checkLBACSelector checks that a rule is a valid label selector (eg. `{ namespace="prod", app=~"api-.*" }`).
+func checkLBACSelector(selector string, allowDots bool) error {
+	trimmed := strings.TrimSpace(selector)
+	if !strings.HasPrefix(trimmed, "{") || !strings.HasSuffix(trimmed, "}") {
+		return fmt.Errorf("invalid selector %q: it must be enclosed in curly braces", selector)
+	}
+
+	matchers := splitLBACMatchers(trimmed[1 : len(trimmed)-1])
+	if len(matchers) == 0 {
+		return fmt.Errorf("invalid selector %q: it must have at least one matcher", selector)
+	}
+	for _, matcher := range matchers {
+		parts := lbacMatcherRegexp.FindStringSubmatch(matcher)
+		if parts == nil {
+			return fmt.Errorf("invalid selector %q: %q is not a matcher like `label=\"value\"`", selector, strings.TrimSpace(matcher))
+		}
+		label, op, quotedValue := parts[1], parts[2], parts[3]
+		if !allowDots && strings.Contains(label, ".") {
+			return fmt.Errorf("invalid selector %q: label %q contains a dot", selector, label)
+		}
+		value, err := strconv.Unquote(quotedValue)
+		if err != nil {
+			return fmt.Errorf("invalid selector %q: invalid value %s: %w", selector, quotedValue, err)
+		}
+		if op == "=~" || op == "!~" {
+			if _, err := regexp.Compile("^(?:" + value + ")$"); err != nil {
+				return fmt.Errorf("invalid selector %q: invalid regular expression %q: %w", selector, value, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitLBACMatchers splits the content of a selector on the commas that are not within quoted values.
+func splitLBACMatchers(s string) []string {
+	var matchers []string
+	inQuotes, escaped, start := false, false, 0
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\' && inQuotes:
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == ',' && !inQuotes:
+			matchers = append(matchers, s[start:i])
+			start = i + 1
+		}
+	}
+	// A trailing comma is allowed
+	if last := s[start:]; strings.TrimSpace(last) != "" {
+		matchers = append(matchers, last)
+	}
+	return matchers
+}
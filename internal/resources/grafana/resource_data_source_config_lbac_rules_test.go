@@ -0,0 +1,103 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceConfigLBACRules_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=11.0.0")
+
+	var ds models.DataSource
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceLBACRulesCheckExists.destroyed(&ds, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfigLBACRules(name, `"{ namespace=\"dev\" }"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					datasourceLBACRulesCheckExists.exists("grafana_data_source_config_lbac_rules.test", &ds),
+					resource.TestCheckResourceAttrPair("grafana_data_source_config_lbac_rules.test", "datasource_uid", "grafana_data_source.test", "uid"),
+					resource.TestCheckResourceAttr("grafana_data_source_config_lbac_rules.test", "team.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_config_lbac_rules.test", "team.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_config_lbac_rules.test", "team.0.rules.0", `{ namespace="dev" }`),
+				),
+			},
+			{
+				Config: testAccDataSourceConfigLBACRules(name, `"{ namespace=~\"prod|staging\" }", "{ app=\"ingress\", cluster!=\"internal\" }"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source_config_lbac_rules.test", "team.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_config_lbac_rules.test", "team.0.rules.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "grafana_data_source_config_lbac_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSourceConfigLBACRules_invalidSelector(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=11.0.0")
+
+	var ds models.DataSource
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceLBACRulesCheckExists.destroyed(&ds, nil),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfigLBACRules(name, `"namespace=\"dev\""`),
+				ExpectError: regexp.MustCompile(`it must be enclosed in curly braces`),
+			},
+			{
+				Config:      testAccDataSourceConfigLBACRules(name, `"{ namespace=~\"(dev\" }"`),
+				ExpectError: regexp.MustCompile(`invalid regular expression`),
+			},
+			// Once the data source exists, the labels are checked against its type at plan time
+			{
+				Config: testAccDataSourceConfigLBACRules(name, `"{ namespace=\"dev\" }"`),
+				Check:  datasourceLBACRulesCheckExists.exists("grafana_data_source_config_lbac_rules.test", &ds),
+			},
+			{
+				Config:      testAccDataSourceConfigLBACRules(name, `"{ resource.service.name=\"checkout\" }"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`label "resource.service.name" contains a dot`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConfigLBACRules(name, rules string) string {
+	return fmt.Sprintf(`
+resource "grafana_team" "test" {
+	name = "%[1]s"
+}
+
+resource "grafana_data_source" "test" {
+	type = "loki"
+	name = "%[1]s"
+	url  = "http://localhost:3100"
+}
+
+resource "grafana_data_source_config_lbac_rules" "test" {
+	datasource_uid = grafana_data_source.test.uid
+
+	team {
+		team_id = grafana_team.test.id
+		rules   = [%[2]s]
+	}
+}
+`, name, rules)
+}
@@ -0,0 +1,312 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/correlations"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceDataSourceCorrelationID = common.NewResourceID(
+	common.OptionalIntIDField("orgID"),
+	common.StringIDField("sourceUID"),
+	common.StringIDField("correlationUID"),
+)
+
+func resourceDataSourceCorrelation() *common.Resource {
+	schema := &schema.Resource{
+
+		Description: `
+Manages a correlation between two data sources, which links the results of the source data source to a query of the target data source in Explore.
+
+**Note:** This resource is available only with Grafana 10.0+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/correlations/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/correlations/)
+`,
+
+		CreateContext: CreateDataSourceCorrelation,
+		ReadContext:   ReadDataSourceCorrelation,
+		UpdateContext: UpdateDataSourceCorrelation,
+		DeleteContext: DeleteDataSourceCorrelation,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the correlation.",
+			},
+			"source_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the data source whose results are linked.",
+			},
+			"target_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the data source that is queried when following the link.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The label of the link.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the correlation.",
+			},
+			"config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The configuration of the correlation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "query",
+							Description:  "The type of the correlation. Currently, only `query` is supported.",
+							ValidateFunc: validation.StringInSlice([]string{"query"}, false),
+						},
+						"field": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The field of the source results on which the link is displayed.",
+						},
+						"target_json": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Serialized JSON string containing the query of the target data source. It can contain variables, like `${field}`, that are replaced by the values of the source results.",
+							ValidateFunc: validation.StringIsJSON,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+							DiffSuppressFunc: common.SuppressEquivalentJSONDiffs,
+						},
+						"transformation": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Transformations extracting variables from the source results, to use in the target query.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The type of the transformation. Available values are `regex` and `logfmt`.",
+										ValidateFunc: validation.StringInSlice([]string{"regex", "logfmt"}, false),
+									},
+									"field": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The field of the source results to transform. Defaults to the field of the correlation.",
+									},
+									"expression": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The regular expression of a `regex` transformation. The first capture group is used as the value of the variable.",
+									},
+									"map_value": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The name of the variable that is set by a `regex` transformation. Defaults to the field name.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_data_source_correlation",
+		resourceDataSourceCorrelationID,
+		schema,
+	).WithLister(listerFunctionOrgResource(listDataSourceCorrelations))
+}
+
+func listDataSourceCorrelations(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	var ids []string
+	var page int64 = 1
+	for {
+		resp, err := client.Correlations.GetCorrelations(correlations.NewGetCorrelationsParams().WithPage(&page))
+		if err != nil {
+			// Grafana responds with a 404 when there are no (more) correlations
+			if common.IsNotFoundError(err) {
+				break
+			}
+			return nil, err
+		}
+		if len(resp.Payload) == 0 {
+			break
+		}
+
+		for _, correlation := range resp.Payload {
+			if correlation.Provisioned {
+				continue
+			}
+			ids = append(ids, resourceDataSourceCorrelationID.Make(orgID, correlation.SourceUID, correlation.UID))
+		}
+		page++
+	}
+
+	return ids, nil
+}
+
+func CreateDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	config, err := correlationConfigFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceUID := d.Get("source_uid").(string)
+	resp, err := client.Correlations.CreateCorrelation(sourceUID, &models.CreateCorrelationCommand{
+		TargetUID:   d.Get("target_uid").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Config:      config,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceDataSourceCorrelationID.Make(orgID, sourceUID, resp.Payload.Result.UID))
+	return ReadDataSourceCorrelation(ctx, d, meta)
+}
+
+func ReadDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, uid, _ := strings.Cut(compositeID, ":")
+
+	resp, err := client.Correlations.GetCorrelation(sourceUID, uid)
+	if err, shouldReturn := common.CheckReadError("correlation", d, err); shouldReturn {
+		return err
+	}
+	correlation := resp.Payload
+
+	d.SetId(resourceDataSourceCorrelationID.Make(orgID, correlation.SourceUID, correlation.UID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("uid", correlation.UID)
+	d.Set("source_uid", correlation.SourceUID)
+	d.Set("target_uid", correlation.TargetUID)
+	d.Set("label", correlation.Label)
+	d.Set("description", correlation.Description)
+
+	if correlation.Config == nil {
+		return diag.FromErr(d.Set("config", nil))
+	}
+	target, err := json.Marshal(correlation.Config.Target)
+	if err != nil {
+		return diag.Errorf("failed to marshal the target of the correlation: %s", err)
+	}
+	config := map[string]interface{}{
+		"type":        "query",
+		"field":       "",
+		"target_json": string(target),
+	}
+	if correlation.Config.Type != nil {
+		config["type"] = string(*correlation.Config.Type)
+	}
+	if correlation.Config.Field != nil {
+		config["field"] = *correlation.Config.Field
+	}
+	transformations := make([]interface{}, 0, len(correlation.Config.Transformations))
+	for _, t := range correlation.Config.Transformations {
+		transformations = append(transformations, map[string]interface{}{
+			"type":       t.Type,
+			"field":      t.Field,
+			"expression": t.Expression,
+			"map_value":  t.MapValue,
+		})
+	}
+	config["transformation"] = transformations
+
+	return diag.FromErr(d.Set("config", []interface{}{config}))
+}
+
+func UpdateDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, uid, _ := strings.Cut(compositeID, ":")
+
+	config, err := correlationConfigFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := correlations.NewUpdateCorrelationParams().
+		WithSourceUID(sourceUID).
+		WithCorrelationUID(uid).
+		WithBody(&models.UpdateCorrelationCommand{
+			Label:       d.Get("label").(string),
+			Description: d.Get("description").(string),
+			Config: &models.CorrelationConfigUpdateDTO{
+				Type:            *config.Type,
+				Field:           *config.Field,
+				Target:          config.Target,
+				Transformations: config.Transformations,
+			},
+		})
+	if _, err := client.Correlations.UpdateCorrelation(params); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDataSourceCorrelation(ctx, d, meta)
+}
+
+func DeleteDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, uid, _ := strings.Cut(compositeID, ":")
+
+	_, err := client.Correlations.DeleteCorrelation(sourceUID, uid)
+	diag, _ := common.CheckReadError("correlation", d, err)
+	return diag
+}
+
+func correlationConfigFromResourceData(d *schema.ResourceData) (*models.CorrelationConfig, error) {
+	config := d.Get("config").([]interface{})[0].(map[string]interface{})
+
+	var target interface{}
+	if err := json.Unmarshal([]byte(config["target_json"].(string)), &target); err != nil {
+		return nil, err
+	}
+
+	transformations := models.Transformations{}
+	for _, raw := range config["transformation"].([]interface{}) {
+		t := raw.(map[string]interface{})
+		transformations = append(transformations, &models.Transformation{
+			Type:       t["type"].(string),
+			Field:      t["field"].(string),
+			Expression: t["expression"].(string),
+			MapValue:   t["map_value"].(string),
+		})
+	}
+
+	return &models.CorrelationConfig{
+		Type:            common.Ref(models.CorrelationConfigType(config["type"].(string))),
+		Field:           common.Ref(config["field"].(string)),
+		Target:          target,
+		Transformations: transformations,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCorrelation_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_data_source_correlation/resource.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafana_data_source_correlation.logs_to_traces", "uid"),
+					resource.TestCheckResourceAttrPair("grafana_data_source_correlation.logs_to_traces", "source_uid", "grafana_data_source.loki", "uid"),
+					resource.TestCheckResourceAttrPair("grafana_data_source_correlation.logs_to_traces", "target_uid", "grafana_data_source.tempo", "uid"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "label", "Trace"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "description", "Open the trace of the log line"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.type", "query"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.field", "traceID"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.target_json", `{"query":"${traceID}"}`),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.0.type", "regex"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.0.expression", `traceID=(\w+)`),
+				),
+			},
+			{
+				ResourceName:      "grafana_data_source_correlation.logs_to_traces",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the correlation in place
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_data_source_correlation/resource.tf", map[string]string{
+					`label       = "Trace"`: `label       = "Open trace"`,
+					`"traceID=(\\w+)"`:      `"trace_id=(\\w+)"`,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "label", "Open trace"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.0.expression", `trace_id=(\w+)`),
+				),
+			},
+		},
+	})
+}
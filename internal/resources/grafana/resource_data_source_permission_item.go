@@ -14,7 +14,7 @@ import (
 
 var (
 	resourceDatasourcePermissionItemName = "grafana_data_source_permission_item"
-	resourceDatasourcePermissionItemID   = common.NewResourceID(common.OptionalIntIDField("orgID"), common.StringIDField("datasourceUID"), common.StringIDField("type (role, team, or user)"), common.StringIDField("identifier"))
+	resourceDatasourcePermissionItemID   = common.NewResourceID(common.OptionalIntIDField("orgID"), common.StringIDField("datasourceUID"), common.StringIDField("type (role, team, user, or service_account)"), common.StringIDField("identifier"))
 
 	// Check interface
 	_ resource.ResourceWithImportState = (*resourceDatasourcePermissionItem)(nil)
@@ -23,7 +23,8 @@ var (
 func makeResourceDatasourcePermissionItem() *common.Resource {
 	resourceStruct := &resourceDatasourcePermissionItem{
 		resourcePermissionBase: resourcePermissionBase{
-			resourceType: datasourcesPermissionsType,
+			resourceType:         datasourcesPermissionsType,
+			serviceAccountTarget: true,
 		},
 	}
 	return common.NewResource(
@@ -35,24 +36,26 @@ func makeResourceDatasourcePermissionItem() *common.Resource {
 }
 
 type resourceDatasourcePermissionItemModel struct {
-	ID            types.String `tfsdk:"id"`
-	OrgID         types.String `tfsdk:"org_id"`
-	Role          types.String `tfsdk:"role"`
-	Team          types.String `tfsdk:"team"`
-	User          types.String `tfsdk:"user"`
-	Permission    types.String `tfsdk:"permission"`
-	DatasourceUID types.String `tfsdk:"datasource_uid"`
+	ID             types.String `tfsdk:"id"`
+	OrgID          types.String `tfsdk:"org_id"`
+	Role           types.String `tfsdk:"role"`
+	Team           types.String `tfsdk:"team"`
+	User           types.String `tfsdk:"user"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+	Permission     types.String `tfsdk:"permission"`
+	DatasourceUID  types.String `tfsdk:"datasource_uid"`
 }
 
 // Framework doesn't support embedding a base struct: https://github.com/hashicorp/terraform-plugin-framework/issues/242
 func (m *resourceDatasourcePermissionItemModel) ToBase() *resourcePermissionItemBaseModel {
 	return &resourcePermissionItemBaseModel{
-		ID:         m.ID,
-		OrgID:      m.OrgID,
-		Role:       m.Role,
-		Team:       m.Team,
-		User:       m.User,
-		Permission: m.Permission,
+		ID:             m.ID,
+		OrgID:          m.OrgID,
+		Role:           m.Role,
+		Team:           m.Team,
+		User:           m.User,
+		Permission:     m.Permission,
+		ServiceAccount: m.ServiceAccount,
 	}
 }
 
@@ -63,6 +66,7 @@ func (m *resourceDatasourcePermissionItemModel) SetFromBase(base *resourcePermis
 	m.Role = base.Role
 	m.Team = base.Team
 	m.User = base.User
+	m.ServiceAccount = base.ServiceAccount
 	m.Permission = base.Permission
 }
 
@@ -74,7 +78,7 @@ func (r *resourceDatasourcePermissionItem) Metadata(ctx context.Context, req res
 
 func (r *resourceDatasourcePermissionItem) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Manages a single permission item for a datasource. Conflicts with the "grafana_data_source_permission" resource which manages the entire set of permissions for a datasource.`,
+		MarkdownDescription: `Manages a single permission item for a datasource. The permission can be assigned to a basic role (Viewer or Editor), a team, a user or a service account. Conflicts with the "grafana_data_source_permission" resource which manages the entire set of permissions for a datasource.`,
 		Attributes: r.addInSchemaAttributes(map[string]schema.Attribute{
 			"datasource_uid": schema.StringAttribute{
 				Required:    true,
@@ -135,6 +139,10 @@ func (r *resourceDatasourcePermissionItem) Read(ctx context.Context, req resourc
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if diags := r.keepTargetReference(data.ToBase(), readData); diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
 	data.SetFromBase(readData)
 
 	// Save data into Terraform state
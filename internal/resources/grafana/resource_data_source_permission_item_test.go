@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -23,6 +24,7 @@ func TestAccDatasourcePermissionItem_basic(t *testing.T) {
 				Config: testAccDatasourcePermissionItem(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					datasourcePermissionsCheckExists.exists("grafana_data_source.foo", &ds),
+					resource.TestMatchResourceAttr("grafana_data_source_permission_item.service_account", "id", regexp.MustCompile(`^1:[a-zA-Z0-9-_]+:service_account:\d+$`)),
 				),
 			},
 			{
@@ -45,6 +47,18 @@ func TestAccDatasourcePermissionItem_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "grafana_data_source_permission_item.service_account",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "grafana_data_source_permission_item.service_account_by_name",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The name reference is resolved to the ID when importing
+				ImportStateVerifyIgnore: []string{"service_account"},
+			},
 		},
 	})
 }
@@ -82,6 +96,16 @@ resource "grafana_service_account" "sa" {
 	role = "Viewer"
 }
 
+resource "grafana_service_account" "sa2" {
+	name = "%[1]s-2"
+	role = "Viewer"
+}
+
+resource "grafana_service_account" "sa3" {
+	name = "%[1]s-3"
+	role = "Viewer"
+}
+
 resource "grafana_data_source_permission_item" "team" {
 	datasource_uid = grafana_data_source.foo.uid
 	team           = grafana_team.team.id
@@ -104,5 +128,17 @@ resource "grafana_data_source_permission_item" "sa" {
 	datasource_uid = grafana_data_source.foo.uid
 	user = grafana_service_account.sa.id
 	permission     = "Query"
+}
+
+resource "grafana_data_source_permission_item" "service_account" {
+	datasource_uid  = grafana_data_source.foo.uid
+	service_account = grafana_service_account.sa2.id
+	permission      = "Edit"
+}
+
+resource "grafana_data_source_permission_item" "service_account_by_name" {
+	datasource_uid  = grafana_data_source.foo.uid
+	service_account = "sa:${grafana_service_account.sa3.name}"
+	permission      = "Query"
 }`, name)
 }
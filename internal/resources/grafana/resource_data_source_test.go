@@ -98,6 +98,14 @@ func TestAccDataSource_Loki(t *testing.T) {
 				// Ignore sensitive attributes, we mostly only care about "json_data_encoded"
 				ImportStateVerifyIgnore: []string{"secure_json_data_encoded", "http_headers."},
 			},
+			// Test import using the name
+			{
+				ResourceName:            "grafana_data_source.loki",
+				ImportState:             true,
+				ImportStateId:           "name:" + dsName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secure_json_data_encoded", "http_headers."},
+			},
 		},
 	})
 }
@@ -426,6 +434,106 @@ func TestAccDataSource_SeparateConfig(t *testing.T) {
 	})
 }
 
+func TestAccDataSource_SecureJSONDataDriftDetection(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource models.DataSource
+	dsName := acctest.RandString(10)
+	config := fmt.Sprintf(`
+	resource "grafana_data_source" "influx" {
+		type = "influxdb"
+		name = "%s"
+		url  = "http://acc-test.invalid/"
+		secure_json_data_encoded = jsonencode({
+			password = "password"
+		})
+		secure_json_data_drift_detection = true
+	}`, dsName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.influx", &dataSource),
+					resource.TestCheckResourceAttrSet("grafana_data_source.influx", "version"),
+				),
+			},
+			// Change the secure JSON data outside of Terraform and check that TF sees a difference
+			{
+				PreConfig: func() {
+					client := grafanaTestClient()
+					_, err := client.Datasources.UpdateDataSourceByUID(dataSource.UID, &models.UpdateDataSourceCommand{
+						Access:         dataSource.Access,
+						Name:           dataSource.Name,
+						Type:           dataSource.Type,
+						URL:            dataSource.URL,
+						JSONData:       dataSource.JSONData,
+						SecureJSONData: map[string]string{"password": "changed"},
+					})
+					if err != nil {
+						t.Fatalf("error updating data source: %s", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Apply again to write back the secure JSON data
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.influx", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.influx", "secure_json_data_encoded", `{"password":"password"}`),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources["grafana_data_source.influx"].Primary.Attributes["version"]; v != strconv.FormatInt(dataSource.Version, 10) {
+							return fmt.Errorf("expected version %d, got %s", dataSource.Version, v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSource_Validate(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource models.DataSource
+	dsName := acctest.RandString(10)
+	config := func(warnOnly bool) string {
+		return fmt.Sprintf(`
+		resource "grafana_data_source" "prometheus" {
+			type               = "prometheus"
+			name               = "%s"
+			url                = "http://acc-test.invalid/"
+			validate           = true
+			validate_warn_only = %t
+		}`, dsName, warnOnly)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config:      config(false),
+				ExpectError: regexp.MustCompile(`Data source "` + dsName + `" is not healthy`),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.prometheus", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "validate", "true"),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "validate_warn_only", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSource_ImportReadOnly(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
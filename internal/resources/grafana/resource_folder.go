@@ -3,8 +3,10 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/folders"
@@ -23,6 +25,8 @@ func resourceFolder() *common.Resource {
 	schema := &schema.Resource{
 
 		Description: `
+Besides the UID, folders can be imported using their title (eg. ` + "`name:My Folder`" + ` or ` + "`<orgID>:name:My Folder`" + `), if no other folder has the same title.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/manage-dashboards/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/folder/)
 `,
@@ -32,7 +36,7 @@ func resourceFolder() *common.Resource {
 		ReadContext:   ReadFolder,
 		UpdateContext: UpdateFolder,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importOrgResourceByName(getFolderUIDByTitle, schema.ImportStatePassthroughContext),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,10 +68,10 @@ func resourceFolder() *common.Resource {
 			"parent_folder_uid": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Description: "The uid of the parent folder. " +
 					"If set, the folder will be nested. " +
 					"If not set, the folder will be created in the root folder. " +
+					"Changing it moves the folder (and all of its content) without recreating it. " +
 					"Note: This requires the nestedFolders feature flag to be enabled on your Grafana instance.",
 			},
 		},
@@ -120,18 +124,51 @@ func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("failed to get folder %s: %s", idStr, err)
 	}
 
-	body := models.UpdateFolderCommand{
-		Overwrite: true,
-		Title:     d.Get("title").(string),
+	if d.HasChange("parent_folder_uid") {
+		if err := moveFolder(client, folder.UID, d.Get("parent_folder_uid").(string)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if _, err := client.Folders.UpdateFolder(folder.UID, &body); err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("title") {
+		body := models.UpdateFolderCommand{
+			Overwrite: true,
+			Title:     d.Get("title").(string),
+		}
+
+		if _, err := client.Folders.UpdateFolder(folder.UID, &body); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return ReadFolder(ctx, d, meta)
 }
 
+// moveFolder moves a folder, along with its subfolders and content, under a new parent.
+// An empty parent UID moves the folder to the root.
+func moveFolder(client *goapi.GrafanaHTTPAPI, uid, parentUID string) error {
+	if parentUID == uid {
+		return fmt.Errorf("folder %s cannot be moved into itself", uid)
+	}
+	if parentUID != "" {
+		// Grafana refuses moves that would create a cycle, but this gives a clearer error
+		parent, err := GetFolderByIDorUID(client.Folders, parentUID)
+		if err != nil {
+			return fmt.Errorf("failed to get new parent folder %s: %w", parentUID, err)
+		}
+		for _, ancestor := range parent.Parents {
+			if ancestor.UID == uid {
+				return fmt.Errorf("folder %s cannot be moved into its own subfolder %s", uid, parentUID)
+			}
+		}
+	}
+
+	if _, err := client.Folders.MoveFolder(uid, &models.MoveFolderCommand{ParentUID: parentUID}); err != nil {
+		return fmt.Errorf("failed to move folder %s: %w", uid, err)
+	}
+	return nil
+}
+
 func ReadFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
 	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
@@ -231,3 +268,25 @@ func GetFolderByIDorUID(client folders.ClientService, id string) (*models.Folder
 	}
 	return resp.GetPayload(), nil
 }
+
+func getFolderUIDByTitle(client *goapi.GrafanaHTTPAPI, title string) (string, error) {
+	params := search.NewSearchParams().WithType(common.Ref("dash-folder")).WithQuery(&title)
+	resp, err := client.Search.Search(params)
+	if err != nil {
+		return "", err
+	}
+	var uids []string
+	for _, folder := range resp.Payload {
+		if folder.Title == title {
+			uids = append(uids, folder.UID)
+		}
+	}
+	switch len(uids) {
+	case 0:
+		return "", fmt.Errorf("no folder titled %q", title)
+	case 1:
+		return uids[0], nil
+	default:
+		return "", fmt.Errorf("%d folders are titled %q, import the folder by UID instead: %s", len(uids), title, strings.Join(uids, ", "))
+	}
+}
@@ -137,6 +137,10 @@ func (r *resourceFolderPermissionItem) Read(ctx context.Context, req resource.Re
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if diags := r.keepTargetReference(data.ToBase(), readData); diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
 	data.SetFromBase(readData)
 
 	// Save data into Terraform state
@@ -9,6 +9,7 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccFolderPermissionItem_basic(t *testing.T) {
@@ -93,6 +94,44 @@ func TestAccFolderPermissionItem_basic(t *testing.T) {
 	})
 }
 
+func TestAccFolderPermissionItem_nameReferences(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	var (
+		folder     models.Folder
+		team       models.TeamDTO
+		sa         models.ServiceAccountDTO
+		randomName = acctest.RandString(6)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderPermissionItemNameReferencesConfig(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.testFolder", &folder),
+					teamCheckExists.exists("grafana_team.testTeam", &team),
+					serviceAccountCheckExists.exists("grafana_service_account.test", &sa),
+					resource.TestCheckResourceAttr("grafana_folder_permission_item.team_viewer", "team", "team:"+randomName),
+					resource.TestCheckResourceAttr("grafana_folder_permission_item.sa_admin", "user", "sa:"+randomName),
+					func(s *terraform.State) error {
+						return checkFolderPermissions(&folder, []*models.DashboardACLInfoDTO{
+							{TeamID: team.ID, PermissionName: "View"},
+							{UserID: sa.ID, PermissionName: "Admin"},
+						})
+					},
+				),
+			},
+			// The references are kept as-is, so there is no diff
+			{
+				Config:   testAccFolderPermissionItemNameReferencesConfig(randomName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccFolderPermissionItemConfig(name string) string {
 	return fmt.Sprintf(`
 resource "grafana_folder" "testFolder" {
@@ -146,3 +185,33 @@ resource "grafana_folder_permission_item" "sa_admin" {
 	permission = "Admin"
 }`, name)
 }
+
+func testAccFolderPermissionItemNameReferencesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "testFolder" {
+	title = "%[1]s"
+}
+
+resource "grafana_team" "testTeam" {
+	name = "%[1]s"
+}
+
+resource "grafana_service_account" "test" {
+	name = "%[1]s"
+	role = "Editor"
+}
+
+resource "grafana_folder_permission_item" "team_viewer" {
+	folder_uid = grafana_folder.testFolder.uid
+	team       = "team:%[1]s"
+	permission = "View"
+	depends_on = [grafana_team.testTeam]
+}
+
+resource "grafana_folder_permission_item" "sa_admin" {
+	folder_uid = grafana_folder.testFolder.uid
+	user       = "sa:%[1]s"
+	permission = "Admin"
+	depends_on = [grafana_service_account.test]
+}`, name)
+}
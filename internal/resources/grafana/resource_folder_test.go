@@ -94,6 +94,14 @@ func TestAccFolder_basic(t *testing.T) {
 					return rs.Primary.Attributes["uid"], nil
 				},
 			},
+			// Test import using the title
+			{
+				ResourceName:            "grafana_folder.test_folder_with_uid",
+				ImportState:             true,
+				ImportStateId:           "name:Terraform Test Folder With UID",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_destroy_if_not_empty"},
+			},
 		},
 	})
 }
@@ -170,6 +178,51 @@ resource grafana_folder child2 {
 	})
 }
 
+func TestAccFolder_move(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	var child models.Folder
+	var grandchild models.Folder
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			folderCheckExists.destroyed(&child, nil),
+			folderCheckExists.destroyed(&grandchild, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMove(name, "grafana_folder.parent_a.uid"),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.child", &child),
+					folderCheckExists.exists("grafana_folder.grandchild", &grandchild),
+					resource.TestCheckResourceAttr("grafana_folder.child", "parent_folder_uid", name+"-a"),
+				),
+			},
+			// Move the child (and its subtree) to another parent
+			{
+				Config: testAccFolderMove(name, "grafana_folder.parent_b.uid"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccFolderWasntRecreated("grafana_folder.child", &child),
+					testAccFolderWasntRecreated("grafana_folder.grandchild", &grandchild),
+					resource.TestCheckResourceAttr("grafana_folder.child", "parent_folder_uid", name+"-b"),
+					resource.TestCheckResourceAttr("grafana_folder.grandchild", "parent_folder_uid", name+"-child"),
+				),
+			},
+			// Move the child to the root folder
+			{
+				Config: testAccFolderMove(name, `""`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccFolderWasntRecreated("grafana_folder.child", &child),
+					resource.TestCheckResourceAttr("grafana_folder.child", "parent_folder_uid", ""),
+					resource.TestCheckResourceAttr("grafana_folder.grandchild", "parent_folder_uid", name+"-child"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFolder_PreventDeletion(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=10.2.0") // Searching by folder UID was added in 10.2.0
 
@@ -346,6 +399,31 @@ func testAccFolderWasntRecreated(rn string, oldFolder *models.Folder) resource.T
 	}
 }
 
+func testAccFolderMove(name string, parentRef string) string {
+	return fmt.Sprintf(`
+resource grafana_folder parent_a {
+	title = "Move Test: Parent A %[1]s"
+	uid   = "%[1]s-a"
+}
+
+resource grafana_folder parent_b {
+	title = "Move Test: Parent B %[1]s"
+	uid   = "%[1]s-b"
+}
+
+resource grafana_folder child {
+	title             = "Move Test: Child %[1]s"
+	uid               = "%[1]s-child"
+	parent_folder_uid = %[2]s
+}
+
+resource grafana_folder grandchild {
+	title             = "Move Test: Grandchild %[1]s"
+	parent_folder_uid = grafana_folder.child.uid
+}
+`, name, parentRef)
+}
+
 func testAccFolderExample_PreventDeletion(name string, preventDeletion bool) string {
 	preventDeletionStr := ""
 	if preventDeletion {
@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"reflect"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -76,11 +77,23 @@ Manages Grafana library panels.
 				Description: "Type of the library panel (eg. text).",
 			},
 			"model_json": {
-				Type:         schema.TypeString,
-				Required:     true,
-				StateFunc:    normalizeLibraryPanelModelJSON,
-				ValidateFunc: validateLibraryPanelModelJSON,
-				Description:  "The JSON model for the library panel.",
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        normalizeLibraryPanelModelJSON,
+				ValidateFunc:     validateLibraryPanelModelJSON,
+				DiffSuppressFunc: suppressLibraryPanelModelJSONDiff,
+				Description:      "The JSON model for the library panel.",
+			},
+			"ignore_json_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "JSONPath expressions of the fields of the panel model that are ignored when comparing `model_json` with the library panel in Grafana. " +
+					"The root `$` is optional. Keys are separated by `.` or quoted within brackets, `[*]` matches all elements of an array and `[N]` matches the element at index N. " +
+					"For example: `$.fieldConfig.defaults.links`, `targets[0].datasource` or `$['options']['legend']`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateJSONPath,
+				},
 			},
 			"version": {
 				Type:        schema.TypeInt,
@@ -120,20 +133,38 @@ Manages Grafana library panels.
 }
 
 func listLibraryPanels(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
-	var ids []string
-	params := library_elements.NewGetLibraryElementsParams().WithKind(common.Ref(libraryPanelKind))
-	resp, err := client.LibraryElements.GetLibraryElements(params)
+	panels, err := getAllLibraryPanels(client)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, panel := range resp.Payload.Result.Elements {
+	var ids []string
+	for _, panel := range panels {
 		ids = append(ids, MakeOrgResourceID(orgID, panel.UID))
 	}
 
 	return ids, nil
 }
 
+// getAllLibraryPanels returns the library panels of the organization, going through all pages of the search.
+func getAllLibraryPanels(client *goapi.GrafanaHTTPAPI) ([]*models.LibraryElementDTO, error) {
+	var panels []*models.LibraryElementDTO
+	var perPage int64 = 100
+	for page := int64(1); ; page++ {
+		params := library_elements.NewGetLibraryElementsParams().WithKind(common.Ref(libraryPanelKind)).WithPage(&page).WithPerPage(&perPage)
+		resp, err := client.LibraryElements.GetLibraryElements(params)
+		if err != nil {
+			return nil, err
+		}
+
+		panels = append(panels, resp.Payload.Result.Elements...)
+
+		if int64(len(resp.Payload.Result.Elements)) < perPage || int64(len(panels)) >= resp.Payload.Result.TotalCount {
+			return panels, nil
+		}
+	}
+}
+
 func createLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _ := OAPIClientFromNewOrgResource(meta, d)
 
@@ -261,6 +292,42 @@ func validateLibraryPanelModelJSON(model interface{}, k string) ([]string, []err
 	return nil, nil
 }
 
+// suppressLibraryPanelModelJSONDiff is the DiffSuppressFunc for `model_json`.
+// It ignores the configured `ignore_json_paths` and null fields.
+func suppressLibraryPanelModelJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	ignorePaths := common.ListToStringSlice(d.Get("ignore_json_paths").([]interface{}))
+	return LibraryPanelModelJSONEquivalent(old, new, ignorePaths)
+}
+
+// LibraryPanelModelJSONEquivalent returns true if both panel models are the same,
+// once normalized and stripped of null fields and of the given paths.
+func LibraryPanelModelJSONEquivalent(old, new string, ignorePaths []string) bool {
+	if old == new {
+		return true
+	}
+
+	semanticModel := func(model string) (interface{}, error) {
+		modelJSON, err := unmarshalLibraryPanelModelJSON(model)
+		if err != nil {
+			return nil, err
+		}
+		var normalized interface{}
+		if err := json.Unmarshal([]byte(normalizeLibraryPanelModelJSON(modelJSON)), &normalized); err != nil {
+			return nil, err
+		}
+		return common.RemoveJSONPaths(common.RemoveJSONNulls(normalized), ignorePaths)
+	}
+	oldModel, err := semanticModel(old)
+	if err != nil {
+		return false
+	}
+	newModel, err := semanticModel(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldModel, newModel)
+}
+
 // normalizeLibraryPanelModelJSON is the StateFunc for the `model_json` field.
 func normalizeLibraryPanelModelJSON(config interface{}) string {
 	var modelJSON map[string]interface{}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -135,6 +136,126 @@ func TestAccLibraryPanel_inOrg(t *testing.T) {
 	})
 }
 
+func TestAccLibraryPanel_ignoreJSONPaths(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=8.0.0")
+
+	name := acctest.RandString(10)
+	var panel models.LibraryElementResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             libraryPanelCheckExists.destroyed(&panel, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibraryPanelIgnoreJSONPaths(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					libraryPanelCheckExists.exists("grafana_library_panel.test", &panel),
+					resource.TestCheckResourceAttr("grafana_library_panel.test", "ignore_json_paths.#", "1"),
+				),
+			},
+			{
+				// Changing an ignored path doesn't produce a diff
+				Config:             testAccLibraryPanelIgnoreJSONPaths(name, "second"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func Test_LibraryPanelModelJSONEquivalent(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	tests := []struct {
+		name        string
+		old         string
+		new         string
+		ignorePaths []string
+		want        bool
+	}{
+		{
+			name: "Key order is ignored",
+			old:  `{"title":"test","type":"text"}`,
+			new:  `{"type":"text","title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Missing description and type are empty",
+			old:  `{"description":"","title":"test","type":""}`,
+			new:  `{"title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Null fields are ignored",
+			old:  `{"options":null,"title":"test"}`,
+			new:  `{"title":"test"}`,
+			want: true,
+		},
+		{
+			name: "Changed fields are not ignored",
+			old:  `{"title":"test"}`,
+			new:  `{"title":"other"}`,
+			want: false,
+		},
+		{
+			name:        "Ignored paths are ignored",
+			old:         `{"fieldConfig":{"defaults":{"links":[{"url":"https://example.com"}]}},"title":"test"}`,
+			new:         `{"fieldConfig":{"defaults":{}},"title":"test"}`,
+			ignorePaths: []string{"fieldConfig.defaults.links"},
+			want:        true,
+		},
+		{
+			name:        "Wildcard paths are ignored",
+			old:         `{"targets":[{"refId":"A","datasource":{"uid":"a"}}],"title":"test"}`,
+			new:         `{"targets":[{"refId":"A","datasource":{"uid":"b"}}],"title":"test"}`,
+			ignorePaths: []string{"targets[*].datasource"},
+			want:        true,
+		},
+		{
+			name:        "JSONPath root is accepted",
+			old:         `{"fieldConfig":{"defaults":{"links":[{"url":"https://example.com"}]}},"title":"test"}`,
+			new:         `{"fieldConfig":{"defaults":{}},"title":"test"}`,
+			ignorePaths: []string{"$.fieldConfig.defaults.links"},
+			want:        true,
+		},
+		{
+			name:        "Indexed paths only ignore the element at the index",
+			old:         `{"targets":[{"refId":"A","datasource":{"uid":"a"}},{"refId":"B","datasource":{"uid":"a"}}],"title":"test"}`,
+			new:         `{"targets":[{"refId":"A","datasource":{"uid":"b"}},{"refId":"B","datasource":{"uid":"a"}}],"title":"test"}`,
+			ignorePaths: []string{"targets[0].datasource"},
+			want:        true,
+		},
+		{
+			name:        "Indexed paths don't ignore the other elements",
+			old:         `{"targets":[{"refId":"A","datasource":{"uid":"a"}},{"refId":"B","datasource":{"uid":"a"}}],"title":"test"}`,
+			new:         `{"targets":[{"refId":"A","datasource":{"uid":"a"}},{"refId":"B","datasource":{"uid":"b"}}],"title":"test"}`,
+			ignorePaths: []string{"$.targets[0].datasource"},
+			want:        false,
+		},
+		{
+			name:        "Bracket-quoted keys are accepted",
+			old:         `{"options":{"legend.mode":"list"},"title":"test"}`,
+			new:         `{"options":{"legend.mode":"table"},"title":"test"}`,
+			ignorePaths: []string{"$['options']['legend.mode']"},
+			want:        true,
+		},
+		{
+			name:        "Invalid paths don't suppress the diff",
+			old:         `{"options":{"a":1},"title":"test"}`,
+			new:         `{"options":{"a":2},"title":"test"}`,
+			ignorePaths: []string{"$options"},
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grafana.LibraryPanelModelJSONEquivalent(tt.old, tt.new, tt.ignorePaths); got != tt.want {
+				t.Errorf("LibraryPanelModelJSONEquivalent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccLibraryPanelBasic(name string) string {
 	return fmt.Sprintf(`
 resource "grafana_library_panel" "test" {
@@ -177,3 +298,20 @@ resource "grafana_library_panel" "test" {
 	})
   }`, orgName)
 }
+
+func testAccLibraryPanelIgnoreJSONPaths(name string, linkTitle string) string {
+	return fmt.Sprintf(`
+resource "grafana_library_panel" "test" {
+	name         = "%[1]s"
+	ignore_json_paths = ["$.fieldConfig.defaults.links"]
+	model_json = jsonencode({
+		title = "%[1]s"
+		type  = "timeseries"
+		fieldConfig = {
+			defaults = {
+				links = [{ title = "%[2]s", url = "https://example.com" }]
+			}
+		}
+	})
+}`, name, linkTitle)
+}
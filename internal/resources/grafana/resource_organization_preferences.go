@@ -41,6 +41,19 @@ func resourceOrganizationPreferences() *common.Resource {
 				Optional:    true,
 				Description: "The Organization home dashboard UID. This is only available in Grafana 9.0+.",
 			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Organization language, as an IETF language tag (eg. `en-US`, `fr-FR`), or an empty string for the default. This is only available in Grafana 10.0+.",
+			},
+			"navbar_bookmark_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IDs of the navigation items that are bookmarked in the navigation menu (eg. `dashboards/browse`, `alerting`). This is only available in Grafana 11.0+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"timezone": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -74,12 +87,17 @@ func listOrganizationPreferences(ctx context.Context, client *goapi.GrafanaHTTPA
 func CreateOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, orgID := OAPIClientFromNewOrgResource(meta, d)
 
-	_, err := client.OrgPreferences.UpdateOrgPreferences(&models.UpdatePrefsCmd{
+	prefs := &models.UpdatePrefsCmd{
 		Theme:            d.Get("theme").(string),
 		HomeDashboardUID: d.Get("home_dashboard_uid").(string),
+		Language:         d.Get("language").(string),
 		Timezone:         d.Get("timezone").(string),
 		WeekStart:        d.Get("week_start").(string),
-	})
+	}
+	if bookmarkIDs := common.ListToStringSlice(d.Get("navbar_bookmark_ids").([]interface{})); len(bookmarkIDs) > 0 {
+		prefs.Navbar = &models.NavbarPreference{BookmarkIds: bookmarkIDs}
+	}
+	_, err := client.OrgPreferences.UpdateOrgPreferences(prefs)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -102,6 +120,12 @@ func ReadOrganizationPreferences(ctx context.Context, d *schema.ResourceData, me
 	d.Set("org_id", d.Id())
 	d.Set("theme", prefs.Theme)
 	d.Set("home_dashboard_uid", prefs.HomeDashboardUID)
+	d.Set("language", prefs.Language)
+	var bookmarkIDs []string
+	if prefs.Navbar != nil {
+		bookmarkIDs = prefs.Navbar.BookmarkIds
+	}
+	d.Set("navbar_bookmark_ids", bookmarkIDs)
 	d.Set("timezone", prefs.Timezone)
 	d.Set("week_start", prefs.WeekStart)
 
@@ -137,6 +137,56 @@ func TestAccResourceOrganizationPreferences(t *testing.T) {
 	})
 }
 
+func TestAccResourceOrganizationPreferences_languageAndNavbar(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.0.0")
+
+	var org models.OrgDetailsDTO
+	testRandName := acctest.RandString(10)
+
+	config := func(language string, bookmarkIDs string) string {
+		return fmt.Sprintf(`
+resource "grafana_organization" "test" {
+	name = "%[1]s"
+}
+
+resource "grafana_organization_preferences" "test" {
+	org_id              = grafana_organization.test.id
+	language            = "%[2]s"
+	navbar_bookmark_ids = [%[3]s]
+}
+`, testRandName, language, bookmarkIDs)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             orgCheckExists.destroyed(&org, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config("fr-FR", `"dashboards/browse", "alerting"`),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization.test", &org),
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "language", "fr-FR"),
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "navbar_bookmark_ids.#", "2"),
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "navbar_bookmark_ids.0", "dashboards/browse"),
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "navbar_bookmark_ids.1", "alerting"),
+				),
+			},
+			{
+				ImportState:       true,
+				ResourceName:      "grafana_organization_preferences.test",
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "language", ""),
+					resource.TestCheckResourceAttr("grafana_organization_preferences.test", "navbar_bookmark_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrganizationPreferences(org *models.OrgDetailsDTO, expectedPrefs models.Preferences) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := grafanaTestClient().WithOrgID(org.ID)
@@ -3,6 +3,8 @@ package grafana
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 
@@ -10,16 +12,24 @@ import (
 	"github.com/grafana/grafana-openapi-client-go/client/playlists"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const playlistItemTypeDashboardByUID = "dashboard_by_uid"
+
+// playlistIntervalRegexp matches the intervals accepted by Grafana, such as `30s`, `5m` or `1h30m`
+var playlistIntervalRegexp = regexp.MustCompile(`^(\d+(ms|s|m|h|d|w|M|y))+$`)
+
 func resourcePlaylist() *common.Resource {
 	schema := &schema.Resource{
 		CreateContext: CreatePlaylist,
 		ReadContext:   ReadPlaylist,
 		UpdateContext: UpdatePlaylist,
 		DeleteContext: DeletePlaylist,
+		CustomizeDiff: playlistCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -38,8 +48,10 @@ func resourcePlaylist() *common.Resource {
 				Description: "The name of the playlist.",
 			},
 			"interval": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The time each dashboard is displayed for, such as `30s`, `5m` or `1h`.",
+				ValidateFunc: validation.StringMatch(playlistIntervalRegexp, "must be a duration such as `30s`, `5m` or `1h`"),
 			},
 			"item": {
 				Type:     schema.TypeSet,
@@ -51,20 +63,30 @@ func resourcePlaylist() *common.Resource {
 							Computed: true,
 						},
 						"order": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The position of the item in the playlist. The order of the blocks is ignored.",
 						},
 						"title": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
-						"type": {
+						"dashboard_uid": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Description: "The UID of the dashboard to display. This is a shorthand for `type = \"dashboard_by_uid\"` and `value = <UID>`, which can't be set along with it. " +
+								"The ID of a `grafana_dashboard` resource (`<orgID>:<uid>`) is also accepted, the org ID is stripped.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The type of the item: `dashboard_by_uid`, `dashboard_by_tag` or `dashboard_by_id`. `dashboard_by_id` is deprecated, dashboards should be referenced by UID.",
+							ValidateFunc: validation.StringInSlice([]string{playlistItemTypeDashboardByUID, "dashboard_by_tag", "dashboard_by_id"}, false),
 						},
 						"value": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of the item: the dashboard UID, tag or ID, depending on the type.",
 						},
 					},
 				},
@@ -140,7 +162,7 @@ func ReadPlaylist(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("name", playlist.Name)
 	d.Set("interval", playlist.Interval)
 	d.Set("org_id", strconv.FormatInt(orgID, 10))
-	if err := d.Set("item", flattenPlaylistItems(itemsResp.Payload)); err != nil {
+	if err := d.Set("item", flattenPlaylistItems(itemsResp.Payload, d.Get("item").(*schema.Set).List())); err != nil {
 		return diag.Errorf("error setting item: %v", err)
 	}
 
@@ -171,6 +193,33 @@ func DeletePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{
 	return diag
 }
 
+// playlistCustomizeDiff rejects items setting both `dashboard_uid` and `type`/`value` at plan time.
+// The items are read from the config, since a dashboard UID that isn't known yet is still set.
+func playlistCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	items := d.GetRawConfig().GetAttr("item")
+	if items.IsNull() || !items.IsKnown() {
+		return nil
+	}
+	isSet := func(v cty.Value) bool {
+		return !v.IsNull() && (!v.IsKnown() || v.AsString() != "")
+	}
+	for it := items.ElementIterator(); it.Next(); {
+		_, item := it.Element()
+		if !item.IsKnown() || item.IsNull() {
+			continue
+		}
+		if !isSet(item.GetAttr("dashboard_uid")) || (!isSet(item.GetAttr("type")) && !isSet(item.GetAttr("value"))) {
+			continue
+		}
+		title := item.GetAttr("title")
+		if !title.IsKnown() || title.IsNull() {
+			return errors.New("`dashboard_uid` can't be set along with `type` and `value`")
+		}
+		return fmt.Errorf("item %q: `dashboard_uid` can't be set along with `type` and `value`", title.AsString())
+	}
+	return nil
+}
+
 func expandPlaylistItems(items []interface{}) []*models.PlaylistItem {
 	playlistItems := make([]*models.PlaylistItem, 0)
 	for _, item := range items {
@@ -185,6 +234,10 @@ func expandPlaylistItems(items []interface{}) []*models.PlaylistItem {
 		if v, ok := itemMap["value"].(string); ok {
 			p.Value = v
 		}
+		if v, ok := itemMap["dashboard_uid"].(string); ok && v != "" {
+			p.Type = playlistItemTypeDashboardByUID
+			_, p.Value = SplitOrgResourceID(v)
+		}
 		playlistItems = append(playlistItems, p)
 	}
 	sort.Slice(playlistItems, func(i, j int) bool {
@@ -193,17 +246,45 @@ func expandPlaylistItems(items []interface{}) []*models.PlaylistItem {
 	return playlistItems
 }
 
-func flattenPlaylistItems(items []*models.PlaylistItem) []interface{} {
+// flattenPlaylistItems converts the items returned by Grafana, which are in the order of the playlist, to the `item` blocks.
+// Recent versions of Grafana don't return the order of the items, so the orders of the current blocks are kept in that case,
+// along with their `dashboard_uid` shorthand.
+func flattenPlaylistItems(items []*models.PlaylistItem, currentItems []interface{}) []interface{} {
+	current := make([]map[string]interface{}, 0, len(currentItems))
+	for _, item := range currentItems {
+		current = append(current, item.(map[string]interface{}))
+	}
+	sort.Slice(current, func(i, j int) bool {
+		return current[i]["order"].(int) < current[j]["order"].(int)
+	})
+
 	playlistItems := make([]interface{}, 0)
 	for i, item := range items {
+		var currentItem map[string]interface{}
+		if i < len(current) {
+			currentItem = current[i]
+		}
 		if item.Order == 0 {
 			item.Order = int64(i + 1)
+			if currentItem != nil {
+				item.Order = int64(currentItem["order"].(int))
+			}
 		}
 		p := map[string]interface{}{
-			"type":  item.Type,
-			"value": item.Value,
-			"order": item.Order,
-			"title": item.Title,
+			"type":          item.Type,
+			"value":         item.Value,
+			"dashboard_uid": "",
+			"order":         item.Order,
+			"title":         item.Title,
+		}
+		if currentItem != nil && item.Type == playlistItemTypeDashboardByUID {
+			if uid, _ := currentItem["dashboard_uid"].(string); uid != "" {
+				if _, currentUID := SplitOrgResourceID(uid); currentUID == item.Value {
+					p["dashboard_uid"] = uid
+					p["type"] = ""
+					p["value"] = ""
+				}
+			}
 		}
 		playlistItems = append(playlistItems, p)
 	}
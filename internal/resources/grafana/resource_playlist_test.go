@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -127,6 +128,114 @@ func TestAccPlaylist_update(t *testing.T) {
 	})
 }
 
+func TestAccPlaylist_dashboardUID(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0") // Items by UID were added in 9.1
+
+	var playlist models.Playlist
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             playlistCheckExists.destroyed(&playlist, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_playlist/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					playlistCheckExists.exists(paylistResource, &playlist),
+					resource.TestCheckResourceAttr(paylistResource, "item.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(paylistResource, "item.*.dashboard_uid", "grafana_dashboard.test", "uid"),
+					resource.TestCheckTypeSetElemNestedAttrs(paylistResource, "item.*", map[string]string{
+						"order": "1",
+						"title": "Terraform Dashboard By UID",
+						"type":  "",
+						"value": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(paylistResource, "item.*", map[string]string{
+						"order": "2",
+						"title": "Terraform Dashboard By Tag",
+						"type":  "dashboard_by_tag",
+						"value": "terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlaylist_explicitOrder(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	var playlist models.Playlist
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             playlistCheckExists.destroyed(&playlist, nil),
+		Steps: []resource.TestStep{
+			{
+				// Orders that aren't consecutive are kept
+				Config: testAccPlaylistConfigOrder(rName),
+				Check: resource.ComposeTestCheckFunc(
+					playlistCheckExists.exists(paylistResource, &playlist),
+					resource.TestCheckTypeSetElemNestedAttrs(paylistResource, "item.*", map[string]string{
+						"order": "10",
+						"title": "first",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(paylistResource, "item.*", map[string]string{
+						"order": "20",
+						"title": "second",
+					}),
+				),
+			},
+			{
+				Config:             testAccPlaylistConfigOrder(rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccPlaylist_invalidInterval(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlaylistConfigBasic(acctest.RandomWithPrefix("tf-acc-test"), "five minutes"),
+				ExpectError: regexp.MustCompile("must be a duration"),
+			},
+		},
+	})
+}
+
+func TestAccPlaylist_dashboardUIDConflict(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "grafana_playlist" "test" {
+  name     = "dashboard uid conflict"
+  interval = "5m"
+
+  item {
+    order         = 1
+    title         = "conflicting item"
+    dashboard_uid = "my-dashboard"
+    type          = "dashboard_by_tag"
+    value         = "my-tag"
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`item "conflicting item": ` + "`dashboard_uid` can't be set along with `type` and `value`"),
+			},
+		},
+	})
+}
+
 func TestAccPlaylist_disappears(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t)
 
@@ -274,3 +383,26 @@ resource "grafana_playlist" "test" {
 }
 `, name, value)
 }
+
+func testAccPlaylistConfigOrder(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_playlist" "test" {
+	name     = %[1]q
+	interval = "1m"
+
+	item {
+		order = 20
+		title = "second"
+		type  = "dashboard_by_tag"
+		value = "second"
+	}
+
+	item {
+		order = 10
+		title = "first"
+		type  = "dashboard_by_tag"
+		value = "first"
+	}
+}
+`, name)
+}
@@ -127,12 +127,19 @@ func resourceReport() *common.Resource {
 			"formats": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: common.AllowedValuesDescription("Specifies what kind of attachment to generate for the report", reportFormats),
+				Description: common.AllowedValuesDescription("Specifies what kind of attachment to generate for the report. Defaults to `pdf`", reportFormats),
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringInSlice(reportFormats, false),
 				},
 			},
+			"scale_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "Scale factor of the dashboard images embedded in the report. A higher value renders images with a higher resolution.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"schedule": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -308,13 +315,15 @@ func ReadReport(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	d.Set("include_table_csv", r.Payload.EnableCSV)
 	d.Set("layout", r.Payload.Options.Layout)
 	d.Set("orientation", r.Payload.Options.Orientation)
+	d.Set("scale_factor", r.Payload.ScaleFactor)
 	d.Set("org_id", strconv.FormatInt(r.Payload.OrgID, 10))
 
-	if _, ok := d.GetOk("formats"); ok {
-		formats := make([]string, len(r.Payload.Formats))
-		for i, format := range r.Payload.Formats {
-			formats[i] = string(format)
-		}
+	// The default format is only read if it is set in the config, other formats are also read on import
+	formats := make([]string, len(r.Payload.Formats))
+	for i, format := range r.Payload.Formats {
+		formats[i] = string(format)
+	}
+	if _, ok := d.GetOk("formats"); ok || len(formats) != 1 || formats[0] != reportFormatPDF {
 		d.Set("formats", common.StringSliceToSet(formats))
 	}
 
@@ -409,7 +418,8 @@ func schemaToReport(d *schema.ResourceData) (models.CreateOrUpdateReport, error)
 			Frequency: frequency,
 			TimeZone:  timezone,
 		},
-		Formats: []models.Type{reportFormatPDF},
+		Formats:     []models.Type{reportFormatPDF},
+		ScaleFactor: int64(d.Get("scale_factor").(int)),
 	}
 
 	report = setDashboards(report, d)
@@ -121,11 +121,17 @@ func TestAccResourceReport_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_report.test", "formats.0", "csv"),
 					resource.TestCheckResourceAttr("grafana_report.test", "formats.1", "image"),
 					resource.TestCheckResourceAttr("grafana_report.test", "formats.2", "pdf"),
+					resource.TestCheckResourceAttr("grafana_report.test", "scale_factor", "3"),
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.0.uid", randomUID),
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.0.time_range.0.from", "now-1h"),
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.0.time_range.0.to", "now"),
 				),
 			},
+			{
+				ResourceName:      "grafana_report.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_report/monthly.tf", map[string]string{
 					`"report-dashboard"`: fmt.Sprintf(`"%s"`, randomUID),
@@ -141,6 +147,18 @@ func TestAccResourceReport_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_report.test", "schedule.0.end_time", ""),  // No end time
 					resource.TestCheckResourceAttr("grafana_report.test", "schedule.0.timezone", "GMT"),
 					resource.TestCheckResourceAttr("grafana_report.test", "schedule.0.last_day_of_month", "true"),
+					// Removed from the config, reset to their defaults
+					resource.TestCheckResourceAttr("grafana_report.test", "formats.#", "0"),
+					resource.TestCheckResourceAttr("grafana_report.test", "scale_factor", "2"),
+					func(s *terraform.State) error {
+						if len(report.Formats) != 1 || report.Formats[0] != "pdf" {
+							return fmt.Errorf("expected the report formats to be reset to pdf, got %v", report.Formats)
+						}
+						if report.ScaleFactor != 2 {
+							return fmt.Errorf("expected the report scale factor to be reset to 2, got %d", report.ScaleFactor)
+						}
+						return nil
+					},
 					resource.TestCheckResourceAttr("grafana_report.test", "orientation", "landscape"),
 					resource.TestCheckResourceAttr("grafana_report.test", "layout", "grid"),
 					resource.TestCheckResourceAttr("grafana_report.test", "include_dashboard_link", "true"),
@@ -14,6 +14,7 @@ func resourceRoleAssignment() *common.Resource {
 	schema := &schema.Resource{
 		Description: `
 Manages the entire set of assignments for a role. Assignments that aren't specified when applying this resource will be removed.
+To manage single assignments without removing the others, use the ` + "`grafana_role_assignment_item`" + ` resource instead.
 **Note:** This resource is available only with Grafana Enterprise 9.2+.
 * [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
@@ -0,0 +1,177 @@
+package grafana
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+)
+
+const (
+	scimSettingsSingletonID = "scim"
+	scimConfigName          = "default"
+)
+
+func resourceSCIMSettings() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the SCIM provisioning settings of an organization, which control how users and teams are synchronized from the identity provider.
+Deleting this resource resets the settings to their defaults.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-scim-provisioning/)
+
+This resource requires Grafana Enterprise or Grafana Cloud, version 12.0.0 or later.
+`,
+
+		CreateContext: putSCIMSettings,
+		ReadContext:   readSCIMSettings,
+		UpdateContext: putSCIMSettings,
+		DeleteContext: deleteSCIMSettings,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"enable_user_sync": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether users are provisioned and deprovisioned through SCIM.",
+			},
+			"enable_group_sync": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether teams and their members are provisioned through SCIM.",
+			},
+			"reject_non_provisioned_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users that were not provisioned through SCIM are prevented from signing in.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaEnterprise,
+		"grafana_scim_settings",
+		orgResourceIDString("anyString"),
+		schema,
+	)
+}
+
+type scimConfigSpec struct {
+	EnableUserSync            bool `json:"enableUserSync"`
+	EnableGroupSync           bool `json:"enableGroupSync"`
+	RejectNonProvisionedUsers bool `json:"rejectNonProvisionedUsers"`
+}
+
+type scimConfigMetadata struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+type scimConfig struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Metadata   scimConfigMetadata `json:"metadata"`
+	Spec       scimConfigSpec     `json:"spec"`
+}
+
+func readSCIMSettings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	namespace, err := getAppPlatformNamespace(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var config scimConfig
+	err = submitAPIRequest(ctx, client, "GetSCIMConfig", http.MethodGet, scimConfigPath(namespace)+"/"+scimConfigName, nil, &config)
+	if err, shouldReturn := common.CheckReadError("SCIM settings", data, err); shouldReturn {
+		return err
+	}
+
+	data.Set("enable_user_sync", config.Spec.EnableUserSync)
+	data.Set("enable_group_sync", config.Spec.EnableGroupSync)
+	data.Set("reject_non_provisioned_users", config.Spec.RejectNonProvisionedUsers)
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.SetId(MakeOrgResourceID(orgID, scimSettingsSingletonID))
+
+	return nil
+}
+
+func putSCIMSettings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+
+	namespace, err := getAppPlatformNamespace(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := scimConfig{
+		APIVersion: "scim.grafana.app/v0alpha1",
+		Kind:       "SCIMConfig",
+		Metadata: scimConfigMetadata{
+			Name:      scimConfigName,
+			Namespace: namespace,
+		},
+		Spec: scimConfigSpec{
+			EnableUserSync:            data.Get("enable_user_sync").(bool),
+			EnableGroupSync:           data.Get("enable_group_sync").(bool),
+			RejectNonProvisionedUsers: data.Get("reject_non_provisioned_users").(bool),
+		},
+	}
+
+	// The config is created if it doesn't exist yet, and replaced otherwise
+	var existing scimConfig
+	err = submitAPIRequest(ctx, client, "GetSCIMConfig", http.MethodGet, scimConfigPath(namespace)+"/"+scimConfigName, nil, &existing)
+	switch {
+	case err == nil:
+		config.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+		err = submitAPIRequest(ctx, client, "ReplaceSCIMConfig", http.MethodPut, scimConfigPath(namespace)+"/"+scimConfigName, config, nil)
+	case common.IsNotFoundError(err):
+		err = submitAPIRequest(ctx, client, "CreateSCIMConfig", http.MethodPost, scimConfigPath(namespace), config, nil)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, scimSettingsSingletonID))
+	return readSCIMSettings(ctx, data, meta)
+}
+
+func deleteSCIMSettings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+
+	namespace, err := getAppPlatformNamespace(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = submitAPIRequest(ctx, client, "DeleteSCIMConfig", http.MethodDelete, scimConfigPath(namespace)+"/"+scimConfigName, nil, nil)
+	diag, _ := common.CheckReadError("SCIM settings", data, err)
+	return diag
+}
+
+func scimConfigPath(namespace string) string {
+	return "/../apis/scim.grafana.app/v0alpha1/namespaces/" + namespace + "/config"
+}
+
+// getAppPlatformNamespace returns the namespace of the current organization in the app platform APIs (eg. `default`, `org-2` or `stacks-123`).
+func getAppPlatformNamespace(ctx context.Context, client *goapi.GrafanaHTTPAPI) (string, error) {
+	var settings struct {
+		Namespace string `json:"namespace"`
+	}
+	if err := submitAPIRequest(ctx, client, "GetFrontendSettings", http.MethodGet, "/frontend/settings", nil, &settings); err != nil {
+		return "", err
+	}
+	return settings.Namespace, nil
+}
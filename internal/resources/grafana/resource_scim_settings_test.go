@@ -0,0 +1,43 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+)
+
+func TestAccSCIMSettings_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=12.0.0")
+
+	// The SCIM settings are a singleton of the default org
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_scim_settings/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_scim_settings.settings", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_scim_settings.settings", "enable_user_sync", "true"),
+					resource.TestCheckResourceAttr("grafana_scim_settings.settings", "enable_group_sync", "false"),
+					resource.TestCheckResourceAttr("grafana_scim_settings.settings", "reject_non_provisioned_users", "false"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_scim_settings/resource.tf", map[string]string{
+					"false": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_scim_settings.settings", "enable_group_sync", "true"),
+					resource.TestCheckResourceAttr("grafana_scim_settings.settings", "reject_non_provisioned_users", "true"),
+				),
+			},
+			{
+				ResourceName:      "grafana_scim_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
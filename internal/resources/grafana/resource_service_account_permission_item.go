@@ -153,6 +153,10 @@ func (r *resourceServiceAccountPermissionItem) Read(ctx context.Context, req res
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if diags := r.keepTargetReference(data.ToBase(), readData); diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
 	readData.ResourceID = data.ServiceAccountID
 	data.SetFromBase(readData)
 
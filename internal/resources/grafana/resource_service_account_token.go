@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -17,11 +18,16 @@ func resourceServiceAccountToken() *common.Resource {
 **Note:** This resource is available only with Grafana 9.1+.
 
 * [Official documentation](https://grafana.com/docs/grafana/latest/administration/service-accounts/)
-* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#service-account-api)`,
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#service-account-api)
+
+The token can be rotated by routine applies: it is regenerated when one of the ` + "`keepers`" + ` changes, or, with ` + "`early_rotation_window_seconds`" + `, when it is about to expire.
+The new token is created before the old one is deleted. Since token names are unique per service account, the names of the regenerated tokens get a suffix with their creation time (eg. ` + "`my-token-20240101120000`" + `).`,
 
 		CreateContext: serviceAccountTokenCreate,
 		ReadContext:   serviceAccountTokenRead,
+		UpdateContext: serviceAccountTokenUpdate,
 		DeleteContext: serviceAccountTokenDelete,
+		CustomizeDiff: serviceAccountTokenCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -42,6 +48,20 @@ func resourceServiceAccountToken() *common.Resource {
 				ForceNew:    true,
 				Description: "The key expiration in seconds. It is optional. If it is a positive number an expiration date for the key is set. If it is null, zero or is omitted completely (unless `api_key_max_seconds_to_live` configuration option is set) the key will never expire.",
 			},
+			"early_rotation_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"seconds_to_live"},
+				Description:  "If set to a positive number, the token is regenerated by the first apply that happens within this many seconds before it expires, or after it has expired.",
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger the token to be regenerated.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"key": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -58,6 +78,11 @@ func resourceServiceAccountToken() *common.Resource {
 				Computed:    true,
 				Description: "The status of the service account token.",
 			},
+			"ready_for_rotation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the token is within its early rotation window (or has expired), and will be regenerated by the next apply.",
+			},
 		},
 	}
 
@@ -70,6 +95,16 @@ func resourceServiceAccountToken() *common.Resource {
 }
 
 func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createServiceAccountToken(d, m, d.Get("name").(string)); diags != nil {
+		return diags
+	}
+
+	// Fill the true resource's state by performing a read
+	return serviceAccountTokenRead(ctx, d, m)
+}
+
+// createServiceAccountToken creates a token with the given name, and sets it as the token of the resource.
+func createServiceAccountToken(d *schema.ResourceData, m interface{}, name string) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
 	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
@@ -77,7 +112,6 @@ func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	name := d.Get("name").(string)
 	ttl := d.Get("seconds_to_live").(int)
 
 	request := models.AddServiceAccountTokenCommand{
@@ -92,12 +126,25 @@ func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 	token := response.Payload
 
 	d.SetId(strconv.FormatInt(token.ID, 10))
-	err = d.Set("key", token.Key)
-	if err != nil {
-		return diag.FromErr(err)
+	return diag.FromErr(d.Set("key", token.Key))
+}
+
+// serviceAccountTokenUpdate regenerates the token if it is ready for rotation or if the keepers changed.
+// The new token is created before the old one is deleted. Otherwise, only the rotation window can be updated, it isn't part of the token.
+func serviceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("ready_for_rotation").(bool) && !d.HasChange("keepers") {
+		return serviceAccountTokenRead(ctx, d, m)
+	}
+
+	oldID := d.Id()
+	name := common.RotatedTokenName(d.Get("name").(string))
+	if diags := createServiceAccountToken(d, m, name); diags != nil {
+		return diags
+	}
+	if err := deleteServiceAccountToken(d, m, oldID); err != nil {
+		return append(serviceAccountTokenRead(ctx, d, m), diag.Errorf("the token was regenerated, but the old token %s could not be deleted: %v", oldID, err)...)
 	}
 
-	// Fill the true resource's state by performing a read
 	return serviceAccountTokenRead(ctx, d, m)
 }
 
@@ -121,7 +168,7 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, key := range response.Payload {
 		if id == key.ID {
 			d.SetId(strconv.FormatInt(key.ID, 10))
-			err = d.Set("name", key.Name)
+			err = d.Set("name", common.TrimRotatedTokenName(d.Get("name").(string), key.Name))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -132,6 +179,16 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 				}
 			}
 			err = d.Set("has_expired", key.HasExpired)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			readyForRotation := false
+			if window := d.Get("early_rotation_window_seconds").(int); window > 0 && !key.Expiration.IsZero() {
+				rotateAt := time.Time(key.Expiration).Add(-time.Duration(window) * time.Second)
+				readyForRotation = key.HasExpired || !time.Now().Before(rotateAt)
+			}
+			err = d.Set("ready_for_rotation", readyForRotation)
 
 			return diag.FromErr(err)
 		}
@@ -141,19 +198,37 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func serviceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(deleteServiceAccountToken(d, m, d.Id()))
+}
+
+func deleteServiceAccountToken(d *schema.ResourceData, m interface{}, tokenID string) error {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
 	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	id, err := strconv.ParseInt(d.Id(), 10, 32)
+	id, err := strconv.ParseInt(tokenID, 10, 32)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	_, err = c.ServiceAccounts.DeleteToken(id, serviceAccountID)
 
-	return diag.FromErr(err)
+	return err
+}
+
+// serviceAccountTokenCustomizeDiff plans the regeneration of the tokens that are ready for rotation, or whose keepers changed.
+// The tokens are regenerated by the update, so that the new token is created before the old one is deleted.
+func serviceAccountTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || (!d.Get("ready_for_rotation").(bool) && !d.HasChange("keepers")) {
+		return nil
+	}
+	for _, key := range []string{"key", "expiration", "has_expired"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -110,6 +111,88 @@ func TestAccServiceAccountToken_inOrg(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountToken_rotation(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	name := acctest.RandString(10)
+	var sa models.ServiceAccountDTO
+	var tokenID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             serviceAccountCheckExists.destroyed(&sa, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountTokenRotationConfig(name, "v1", 0),
+				Check: resource.ComposeTestCheckFunc(
+					serviceAccountCheckExists.exists("grafana_service_account.test", &sa),
+					checkServiceAccountTokens(&sa, []string{name}),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "ready_for_rotation", "false"),
+					testAccServiceAccountTokenID(&tokenID, false),
+				),
+			},
+			// Changing a keeper regenerates the token, the old token is deleted after the new one is created
+			{
+				Config: testAccServiceAccountTokenRotationConfig(name, "v2", 0),
+				Check: resource.ComposeTestCheckFunc(
+					checkServiceAccountRotatedToken(&sa, name),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "name", name),
+					testAccServiceAccountTokenID(&tokenID, true),
+				),
+			},
+			// The token expires within the rotation window, so it is regenerated by each apply
+			{
+				Config:             testAccServiceAccountTokenRotationConfig(name, "v2", 600),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "ready_for_rotation", "true"),
+				),
+			},
+			{
+				Config:             testAccServiceAccountTokenRotationConfig(name, "v2", 600),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					checkServiceAccountRotatedToken(&sa, name),
+					testAccServiceAccountTokenID(&tokenID, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccServiceAccountTokenID stores the ID of the token, and checks whether it changed since it was last stored.
+func testAccServiceAccountTokenID(tokenID *string, expectChanged bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["grafana_service_account_token.test"]
+		if !ok {
+			return fmt.Errorf("resource not found")
+		}
+		if changed := rs.Primary.ID != *tokenID; *tokenID != "" && changed != expectChanged {
+			return fmt.Errorf("expected token ID change to be %t, got ID %s (previously %s)", expectChanged, rs.Primary.ID, *tokenID)
+		}
+		*tokenID = rs.Primary.ID
+		return nil
+	}
+}
+
+// checkServiceAccountRotatedToken checks that the service account only has a regenerated token, whose name is suffixed.
+func checkServiceAccountRotatedToken(sa *models.ServiceAccountDTO, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := grafanaTestClient().WithOrgID(sa.OrgID)
+		resp, err := client.ServiceAccounts.ListTokens(sa.ID)
+		if err != nil {
+			return err
+		}
+		if len(resp.Payload) != 1 {
+			return fmt.Errorf("Expected 1 token, got %d", len(resp.Payload))
+		}
+		if tokenName := resp.Payload[0].Name; !strings.HasPrefix(tokenName, name+"-") {
+			return fmt.Errorf("Expected the name of the regenerated token to start with %s-, got %s", name, tokenName)
+		}
+		return nil
+	}
+}
+
 func checkServiceAccountTokens(sa *models.ServiceAccountDTO, expectNames []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := grafanaTestClient().WithOrgID(sa.OrgID)
@@ -170,3 +253,22 @@ resource "grafana_service_account_token" "test" {
 }
 `, name, role, secondsToLiveAttr, orgIDAttr)
 }
+
+func testAccServiceAccountTokenRotationConfig(name, keeper string, earlyRotationWindow int) string {
+	return fmt.Sprintf(`
+resource "grafana_service_account" "test" {
+	name = "%[1]s"
+	role = "Viewer"
+}
+
+resource "grafana_service_account_token" "test" {
+	name                          = "%[1]s"
+	service_account_id            = grafana_service_account.test.id
+	seconds_to_live               = 300
+	early_rotation_window_seconds = %[3]d
+	keepers = {
+		version = "%[2]s"
+	}
+}
+`, name, keeper, earlyRotationWindow)
+}
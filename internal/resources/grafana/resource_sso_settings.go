@@ -18,6 +18,7 @@ const (
 	providerKey       = "provider_name"
 	oauth2SettingsKey = "oauth2_settings"
 	samlSettingsKey   = "saml_settings"
+	ldapSettingsKey   = "ldap_settings"
 	customFieldsKey   = "custom"
 )
 
@@ -25,7 +26,8 @@ func resourceSSOSettings() *common.Resource {
 	schema := &schema.Resource{
 
 		Description: `
-Manages Grafana SSO Settings for OAuth2 and SAML. Support for SAML is currently in preview, it will be available in Grafana Enterprise starting with v11.1.
+Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for SAML is currently in preview, it will be available in Grafana Enterprise starting with v11.1.
+Support for LDAP requires Grafana 11.3 or later, with the ` + "`ssoSettingsLDAP`" + ` feature toggle enabled.
 
 * [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-authentication/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/sso-settings/)
@@ -43,8 +45,8 @@ Manages Grafana SSO Settings for OAuth2 and SAML. Support for SAML is currently
 			providerKey: {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "The name of the SSO provider. Supported values: github, gitlab, google, azuread, okta, generic_oauth, saml.",
-				ValidateFunc: validation.StringInSlice([]string{"github", "gitlab", "google", "azuread", "okta", "generic_oauth", "saml"}, false),
+				Description:  "The name of the SSO provider. Supported values: github, gitlab, google, azuread, okta, generic_oauth, saml, ldap.",
+				ValidateFunc: validation.StringInSlice([]string{"github", "gitlab", "google", "azuread", "okta", "generic_oauth", "saml", "ldap"}, false),
 			},
 			oauth2SettingsKey: {
 				Type:          schema.TypeSet,
@@ -53,7 +55,7 @@ Manages Grafana SSO Settings for OAuth2 and SAML. Support for SAML is currently
 				MinItems:      0,
 				Description:   "The OAuth2 settings set. Required for github, gitlab, google, azuread, okta, generic_oauth providers.",
 				Elem:          oauth2SettingsSchema,
-				ConflictsWith: []string{samlSettingsKey},
+				ConflictsWith: []string{samlSettingsKey, ldapSettingsKey},
 			},
 			samlSettingsKey: {
 				Type:          schema.TypeSet,
@@ -62,7 +64,16 @@ Manages Grafana SSO Settings for OAuth2 and SAML. Support for SAML is currently
 				MinItems:      0,
 				Description:   "The SAML settings set. Required for the saml provider.",
 				Elem:          samlSettingsSchema,
-				ConflictsWith: []string{oauth2SettingsKey},
+				ConflictsWith: []string{oauth2SettingsKey, ldapSettingsKey},
+			},
+			ldapSettingsKey: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      1,
+				MinItems:      0,
+				Description:   "The LDAP settings set. Required for the ldap provider.",
+				Elem:          ldapSettingsSchema,
+				ConflictsWith: []string{oauth2SettingsKey, samlSettingsKey},
 			},
 		},
 	}
@@ -474,6 +485,199 @@ var samlSettingsSchema = &schema.Resource{
 	},
 }
 
+var ldapSettingsSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Define whether this configuration is enabled for LDAP.",
+		},
+		"allow_sign_up": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to allow new Grafana user creation through LDAP login. If set to false, then only existing Grafana users can log in with LDAP.",
+		},
+		"skip_org_role_sync": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Prevent synchronizing users’ organization roles from LDAP.",
+		},
+		"config": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The LDAP configuration.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"servers": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Description: "The LDAP servers. They are tried in order until the user is found.",
+						Elem:        ldapServerSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+var ldapServerSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"host": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The LDAP server host. Several hosts can be given, separated by spaces.",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     389,
+			Description: "The LDAP server port. Usually 389, or 636 with SSL.",
+		},
+		"use_ssl": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to use SSL (LDAPS) to connect to the server.",
+		},
+		"start_tls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to use StartTLS to secure the connection to the server, instead of SSL.",
+		},
+		"ssl_skip_verify": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If enabled, the SSL certificate of the server is not verified.",
+		},
+		"root_ca_cert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The path to the root CA certificate file.",
+		},
+		"root_ca_cert_value": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Base64-encoded values of the root CA certificates.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"client_cert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The path to the client certificate file.",
+		},
+		"client_cert_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The Base64-encoded value of the client certificate.",
+		},
+		"client_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The path to the client private key file.",
+		},
+		"client_key_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The Base64-encoded value of the client private key.",
+		},
+		"min_tls_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The minimum TLS version to use, eg. TLS1.2 or TLS1.3.",
+		},
+		"tls_ciphers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The TLS ciphers to accept.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The timeout in seconds of the connection to the server.",
+		},
+		"bind_dn": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The DN of the user that binds to the server to search for users, eg. cn=admin,dc=grafana,dc=org. It can contain `%s`, which is replaced by the username of the user that logs in.",
+		},
+		"bind_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The password of the bind user.",
+		},
+		"search_filter": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The user search filter, eg. (cn=%s).",
+		},
+		"search_base_dns": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "The base DNs to search users in.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"group_search_filter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The group search filter, for LDAP servers that don't support the memberOf attribute, eg. (&(objectClass=posixGroup)(memberUid=%s)).",
+		},
+		"group_search_filter_user_attribute": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The user attribute that replaces `%s` in the group search filter.",
+		},
+		"group_search_base_dns": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The base DNs to search groups in.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"attributes": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "The LDAP attributes of the user fields. The supported keys are name, surname, username, member_of and email.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"group_mappings": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The mappings of LDAP groups to the roles of the users in the organizations.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"group_dn": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The DN of the LDAP group, or * to match all users.",
+					},
+					"org_role": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "The role of the members of the group in the organization: Admin, Editor, Viewer or None.",
+						ValidateFunc: validation.StringInSlice([]string{"Admin", "Editor", "Viewer", "None"}, false),
+					},
+					"org_id": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Default:     1,
+						Description: "The ID of the organization.",
+					},
+					"grafana_admin": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether the members of the group are Grafana server administrators.",
+					},
+				},
+			},
+		},
+	},
+}
+
 func ReadSSOSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _ := OAPIGlobalClient(meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
 
@@ -495,6 +699,12 @@ func ReadSSOSettings(ctx context.Context, d *schema.ResourceData, meta interface
 
 	payload := resp.GetPayload()
 
+	if isLdapProvider(provider) {
+		d.Set(providerKey, payload.Provider)
+		d.Set(settingsKey, []interface{}{ldapSettingsFromAPI(payload.Settings.(map[string]any), d.Get(settingsKey).(*schema.Set).List())})
+		return nil
+	}
+
 	var settingsFromTfState map[string]any
 	settingsFromTfStateList := d.Get(settingsKey).(*schema.Set).List()
 	if len(settingsFromTfStateList) > 0 {
@@ -575,6 +785,10 @@ func UpdateSSOSettings(ctx context.Context, d *schema.ResourceData, meta interfa
 		settings = mergeCustomFields(settings)
 	}
 
+	if isLdapProvider(provider) {
+		settings = ldapSettingsToAPI(settings)
+	}
+
 	err = validateSSOSettings(provider, settings)
 	if err != nil {
 		return diag.FromErr(err)
@@ -619,6 +833,10 @@ func isSamlProvider(provider string) bool {
 	return provider == "saml"
 }
 
+func isLdapProvider(provider string) bool {
+	return provider == "ldap"
+}
+
 func getSettingsKey(provider string) (string, error) {
 	if isOAuth2Provider(provider) {
 		return oauth2SettingsKey, nil
@@ -626,6 +844,9 @@ func getSettingsKey(provider string) (string, error) {
 	if isSamlProvider(provider) {
 		return samlSettingsKey, nil
 	}
+	if isLdapProvider(provider) {
+		return ldapSettingsKey, nil
+	}
 
 	return "", fmt.Errorf("no settings key found for provider %s", provider)
 }
@@ -637,6 +858,9 @@ func getSettingsSchema(provider string) (*schema.Resource, error) {
 	if isSamlProvider(provider) {
 		return samlSettingsSchema, nil
 	}
+	if isLdapProvider(provider) {
+		return ldapSettingsSchema, nil
+	}
 
 	return nil, fmt.Errorf("no settings schema found for provider %s", provider)
 }
@@ -670,6 +894,7 @@ func getSettingsFromResourceData(d *schema.ResourceData, settingsKey string) (ma
 	// we are only interested in the settings that have one of the following:
 	// - the client_id set because the client_id is a required field for OAuth2 providers
 	// - the private_key or private_key_path set because those are required fields for SAML
+	// - the config set because it is a required field for LDAP
 	for _, item := range settingsList {
 		settings := item.(map[string]any)
 
@@ -683,6 +908,10 @@ func getSettingsFromResourceData(d *schema.ResourceData, settingsKey string) (ma
 		if (okPrivateKey && privateKey != "") || (okPrivateKeyPath && privateKeyPath != "") {
 			return settings, nil
 		}
+
+		if config, ok := settings["config"].([]interface{}); ok && len(config) > 0 {
+			return settings, nil
+		}
 	}
 
 	return nil, fmt.Errorf("no valid settings found for the provider %s", d.Get(providerKey).(string))
@@ -931,3 +1160,124 @@ func ssoValidateInterdependencyXOR(keys ...string) validateFunc {
 		return nil
 	}
 }
+
+// ldapSettingsToAPI converts the LDAP settings of the terraform configuration to the format of the SSO Settings API,
+// where the config is an object and the servers keep the snake_case keys of the LDAP configuration file.
+func ldapSettingsToAPI(settings map[string]any) map[string]any {
+	servers := []any{}
+	if config, ok := settings["config"].([]interface{}); ok && len(config) > 0 && config[0] != nil {
+		servers = config[0].(map[string]any)["servers"].([]interface{})
+	}
+
+	return map[string]any{
+		"enabled":         settings["enabled"],
+		"allowSignUp":     settings["allow_sign_up"],
+		"skipOrgRoleSync": settings["skip_org_role_sync"],
+		"config": map[string]any{
+			"servers": servers,
+		},
+	}
+}
+
+// ldapSettingsFromAPI converts the LDAP settings returned by the SSO Settings API to the terraform format.
+// The secrets are not exposed by the API, so they are taken from the servers in the terraform state, by position.
+func ldapSettingsFromAPI(settings map[string]any, settingsFromTfStateList []interface{}) map[string]any {
+	var serversFromTfState []interface{}
+	if len(settingsFromTfStateList) > 0 && settingsFromTfStateList[0] != nil {
+		if config, ok := settingsFromTfStateList[0].(map[string]any)["config"].([]interface{}); ok && len(config) > 0 && config[0] != nil {
+			serversFromTfState = config[0].(map[string]any)["servers"].([]interface{})
+		}
+	}
+
+	apiSettings := make(map[string]any)
+	for k, v := range settings {
+		apiSettings[toSnake(k)] = v
+	}
+
+	var apiServers []interface{}
+	if config, ok := apiSettings["config"].(map[string]any); ok {
+		apiServers, _ = config["servers"].([]interface{})
+	}
+
+	servers := make([]interface{}, 0, len(apiServers))
+	for i, apiServer := range apiServers {
+		serverFromAPI := make(map[string]any)
+		for k, v := range apiServer.(map[string]any) {
+			serverFromAPI[toSnake(k)] = v
+		}
+		var serverFromTfState map[string]any
+		if i < len(serversFromTfState) && serversFromTfState[i] != nil {
+			serverFromTfState = serversFromTfState[i].(map[string]any)
+		}
+
+		server := make(map[string]any)
+		for key, fieldSchema := range ldapServerSchema.Schema {
+			switch {
+			case key == "group_mappings":
+				continue
+			case fieldSchema.Sensitive:
+				server[key] = serverFromTfState[key]
+			default:
+				server[key] = ldapValueFromAPI(fieldSchema, serverFromAPI[key])
+			}
+		}
+
+		groupMappings := []interface{}{}
+		apiGroupMappings, _ := serverFromAPI["group_mappings"].([]interface{})
+		groupMappingSchema := ldapServerSchema.Schema["group_mappings"].Elem.(*schema.Resource)
+		for _, apiGroupMapping := range apiGroupMappings {
+			groupMapping := make(map[string]any)
+			for k, v := range apiGroupMapping.(map[string]any) {
+				if fieldSchema, ok := groupMappingSchema.Schema[toSnake(k)]; ok {
+					groupMapping[toSnake(k)] = ldapValueFromAPI(fieldSchema, v)
+				}
+			}
+			groupMappings = append(groupMappings, groupMapping)
+		}
+		server["group_mappings"] = groupMappings
+
+		servers = append(servers, server)
+	}
+
+	return map[string]any{
+		"enabled":            apiSettings["enabled"] == true,
+		"allow_sign_up":      apiSettings["allow_sign_up"] == true,
+		"skip_org_role_sync": apiSettings["skip_org_role_sync"] == true,
+		"config": []interface{}{
+			map[string]any{"servers": servers},
+		},
+	}
+}
+
+// ldapValueFromAPI converts a value decoded from the JSON of the SSO Settings API to the type of the field in the schema.
+func ldapValueFromAPI(fieldSchema *schema.Schema, v any) any {
+	switch fieldSchema.Type {
+	case schema.TypeInt:
+		if f, ok := v.(float64); ok {
+			return int(f)
+		}
+		return 0
+	case schema.TypeBool:
+		return v == true
+	case schema.TypeString:
+		if str, ok := v.(string); ok {
+			return str
+		}
+		return ""
+	case schema.TypeList:
+		if fieldSchema.Elem.(*schema.Schema).Type == schema.TypeString {
+			list := []interface{}{}
+			values, _ := v.([]interface{})
+			for _, value := range values {
+				list = append(list, value)
+			}
+			return list
+		}
+	case schema.TypeMap:
+		if m, ok := v.(map[string]any); ok {
+			return m
+		}
+		return map[string]any{}
+	}
+	return v
+}
@@ -134,6 +134,61 @@ func TestSSOSettings_basic_saml(t *testing.T) {
 	})
 }
 
+func TestSSOSettings_basic_ldap(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.3.0")
+
+	provider := "ldap"
+
+	api := grafanaTestClient()
+
+	defaultSettings, err := api.SsoSettings.GetProviderSettings(provider)
+	if err != nil {
+		t.Fatalf("failed to fetch the default settings for provider %s: %v", provider, err)
+	}
+
+	resourceName := "grafana_sso_settings.ldap_sso_settings"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             checkSsoSettingsReset(api, provider, defaultSettings.Payload),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigForLdapProvider("cn=admin,dc=grafana,dc=org", "Editor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "provider_name", provider),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.host", "127.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.port", "3389"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.bind_dn", "cn=admin,dc=grafana,dc=org"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.bind_password", "grafana"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.search_base_dns.0", "dc=grafana,dc=org"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.attributes.email", "email"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.0.org_role", "Admin"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.0.grafana_admin", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.1.org_role", "Editor"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.1.org_id", "1"),
+				),
+			},
+			{
+				Config: testConfigForLdapProvider("cn=reader,dc=grafana,dc=org", "Viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.bind_dn", "cn=reader,dc=grafana,dc=org"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.1.org_role", "Viewer"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ldap_settings.0.config.0.servers.0.bind_password"},
+			},
+		},
+	})
+}
+
 func TestSSOSettings_customFields(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t) // TODO: Fix the tests to run on local instances
 
@@ -373,6 +428,36 @@ func testConfigForOAuth2Provider(provider string, prefix string) string {
 }`, prefix, provider, urls)
 }
 
+func testConfigForLdapProvider(bindDN string, usersRole string) string {
+	return fmt.Sprintf(`resource "grafana_sso_settings" "ldap_sso_settings" {
+  provider_name = "ldap"
+  ldap_settings {
+    config {
+      servers {
+        host            = "127.0.0.1"
+        port            = 3389
+        search_filter   = "(cn=%%s)"
+        bind_dn         = "%[1]s"
+        bind_password   = "grafana"
+        search_base_dns = ["dc=grafana,dc=org"]
+        attributes = {
+          email = "email"
+        }
+        group_mappings {
+          group_dn      = "cn=admins,dc=grafana,dc=org"
+          org_role      = "Admin"
+          grafana_admin = true
+        }
+        group_mappings {
+          group_dn = "cn=users,dc=grafana,dc=org"
+          org_role = "%[2]s"
+        }
+      }
+    }
+  }
+}`, bindDN, usersRole)
+}
+
 // the SAML configuration needs a valid certificate, private_key and idp_metadata to be accepted by Grafana API
 const testConfigForSamlProvider = `resource "grafana_sso_settings" "saml_sso_settings" {
   provider_name = "saml"
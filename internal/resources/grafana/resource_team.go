@@ -36,6 +36,8 @@ func resourceTeam() *common.Resource {
 	schema := &schema.Resource{
 
 		Description: `
+Besides the ID, teams can be imported using their name (eg. ` + "`name:Payments Team`" + ` or ` + "`<orgID>:name:Payments Team`" + `).
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/administration/team-management/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/team/)
 `,
@@ -45,7 +47,7 @@ func resourceTeam() *common.Resource {
 		UpdateContext: UpdateTeam,
 		DeleteContext: DeleteTeam,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importOrgResourceByName(getTeamIDByName, schema.ImportStatePassthroughContext),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,9 +97,10 @@ Team Sync can be provisioned using [grafana_team_external_group resource](https:
 `,
 			},
 			"preferences": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Equivalent to the `grafana_team_preferences` resource. Use one or the other to configure a team's preferences.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"theme": {
@@ -220,10 +223,14 @@ func ReadTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 	_, readTeamSync := d.GetOk("team_sync")
-	return readTeamFromID(client, teamID, d, readTeamSync)
+	// The preferences may be managed by the `grafana_team_preferences` resource instead, so they are only read if they are set
+	// The name is only empty when importing, the preferences are read in that case
+	_, readPreferences := d.GetOk("preferences")
+	readPreferences = readPreferences || d.Get("name").(string) == ""
+	return readTeamFromID(client, teamID, d, readTeamSync, readPreferences)
 }
 
-func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.ResourceData, readTeamSync bool) diag.Diagnostics {
+func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.ResourceData, readTeamSync, readPreferences bool) diag.Diagnostics {
 	teamIDStr := strconv.FormatInt(teamID, 10)
 	team, err := getTeamByID(client, teamID)
 	if err, shouldReturn := common.CheckReadError("team", d, err); shouldReturn {
@@ -262,7 +269,7 @@ func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.Resour
 		})
 	}
 
-	if preferences.Theme+preferences.Timezone+preferences.HomeDashboardUID+preferences.WeekStart != "" {
+	if readPreferences && preferences.Theme+preferences.Timezone+preferences.HomeDashboardUID+preferences.WeekStart != "" {
 		d.Set("preferences", []map[string]interface{}{
 			{
 				"theme":              preferences.Theme,
@@ -470,3 +477,16 @@ func getTeamByID(client *goapi.GrafanaHTTPAPI, teamID int64) (*models.TeamDTO, e
 	}
 	return resp.GetPayload(), nil
 }
+
+func getTeamIDByName(client *goapi.GrafanaHTTPAPI, name string) (string, error) {
+	resp, err := client.Teams.SearchTeams(teams.NewSearchTeamsParams().WithName(&name))
+	if err != nil {
+		return "", err
+	}
+	for _, team := range resp.Payload.Teams {
+		if team.Name == name {
+			return strconv.FormatInt(team.ID, 10), nil
+		}
+	}
+	return "", fmt.Errorf("no team named %q", name)
+}
@@ -15,7 +15,7 @@ import (
 
 func resourceTeamExternalGroup() *common.Resource {
 	schema := &schema.Resource{
-		Description: "Equivalent to the the `team_sync` attribute of the `grafana_team` resource. Use one or the other to configure a team's external groups syncing config.",
+		Description: "Equivalent to the the `team_sync` attribute of the `grafana_team` resource. Use one or the other to configure a team's external groups syncing config. Conflicts with the `grafana_team_external_group_item` resource, which manages a single external group.",
 
 		CreateContext: CreateTeamExternalGroup,
 		UpdateContext: UpdateTeamExternalGroup,
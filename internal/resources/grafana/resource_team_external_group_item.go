@@ -0,0 +1,193 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	resourceTeamExternalGroupItemName = "grafana_team_external_group_item"
+	resourceTeamExternalGroupItemID   = common.NewResourceID(common.OptionalIntIDField("orgID"), common.IntIDField("teamID"), common.TrailingStringIDField("group"))
+
+	// Check interface
+	_ resource.ResourceWithImportState = (*resourceTeamExternalGroupItem)(nil)
+)
+
+func makeResourceTeamExternalGroupItem() *common.Resource {
+	return common.NewResource(
+		common.CategoryGrafanaEnterprise,
+		resourceTeamExternalGroupItemName,
+		resourceTeamExternalGroupItemID,
+		&resourceTeamExternalGroupItem{},
+	)
+}
+
+type resourceTeamExternalGroupItemModel struct {
+	ID     types.String `tfsdk:"id"`
+	OrgID  types.String `tfsdk:"org_id"`
+	TeamID types.String `tfsdk:"team_id"`
+	Group  types.String `tfsdk:"group"`
+}
+
+type resourceTeamExternalGroupItem struct {
+	basePluginFrameworkResource
+}
+
+func (r *resourceTeamExternalGroupItem) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = resourceTeamExternalGroupItemName
+}
+
+func (r *resourceTeamExternalGroupItem) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a single external group (eg. an LDAP, SAML or OAuth group) synced to a team. Conflicts with the "grafana_team_external_group" resource and the "team_sync" attribute of the "grafana_team" resource, which manage the entire set of external groups of a team.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": pluginFrameworkOrgIDAttribute(),
+			"team_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the team.",
+				PlanModifiers: []planmodifier.String{
+					&orgScopedAttributePlanModifier{},
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The external group to sync to the team.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTeamExternalGroupItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data, diags := r.read(req.ID)
+	if diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError("Resource not found", "Resource not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *resourceTeamExternalGroupItem) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data resourceTeamExternalGroupItemModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get client", err.Error())
+		return
+	}
+
+	_, teamIDStr := SplitOrgResourceID(data.TeamID.ValueString())
+	teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse team ID", err.Error())
+		return
+	}
+
+	if err := applyTeamExternalGroup(client, teamID, []string{data.Group.ValueString()}, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to add external group", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	data.ID = types.StringValue(resourceTeamExternalGroupItemID.Make(orgID, teamID, data.Group.ValueString()))
+	data.OrgID = types.StringValue(strconv.FormatInt(orgID, 10))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *resourceTeamExternalGroupItem) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data resourceTeamExternalGroupItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Read from API
+	readData, diags := r.read(data.ID.ValueString())
+	if diags != nil {
+		resp.Diagnostics = diags
+		return
+	}
+	if readData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, readData)...)
+}
+
+func (r *resourceTeamExternalGroupItem) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update shouldn't happen as all attributes require replacement
+	resp.Diagnostics.AddError("Update not supported", "Update not supported")
+}
+
+func (r *resourceTeamExternalGroupItem) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data resourceTeamExternalGroupItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	client, _, idFields, err := r.clientFromExistingOrgResource(resourceTeamExternalGroupItemID, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get client", err.Error())
+		return
+	}
+	teamID, group := idFields[0].(int64), idFields[1].(string)
+
+	if err := applyTeamExternalGroup(client, teamID, nil, []string{group}); err != nil && !common.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to remove external group", err.Error())
+	}
+}
+
+func (r *resourceTeamExternalGroupItem) read(id string) (*resourceTeamExternalGroupItemModel, diag.Diagnostics) {
+	client, orgID, idFields, err := r.clientFromExistingOrgResource(resourceTeamExternalGroupItemID, id)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get client", err.Error())}
+	}
+	teamID, group := idFields[0].(int64), idFields[1].(string)
+
+	resp, err := client.SyncTeamGroups.GetTeamGroupsAPI(teamID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get team groups", err.Error())}
+	}
+
+	for _, teamGroup := range resp.Payload {
+		if teamGroup.GroupID == group {
+			return &resourceTeamExternalGroupItemModel{
+				ID:     types.StringValue(resourceTeamExternalGroupItemID.Make(orgID, teamID, group)),
+				OrgID:  types.StringValue(strconv.FormatInt(orgID, 10)),
+				TeamID: types.StringValue(strconv.FormatInt(teamID, 10)),
+				Group:  types.StringValue(group),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -114,3 +114,87 @@ func testAccTeamExternalGroupConfig(name string, groups []string) string {
 		groups = [ %s ]
 	}`, name, groupsString)
 }
+
+func TestAccTeamExternalGroupItem_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	name := acctest.RandString(10)
+	var team models.TeamDTO
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamExternalGroupItemConfig(name, []string{"test-group1", "test-group2"}),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					testAccTeamExternalGroupCheck(&team, []string{"test-group1", "test-group2"}),
+					resource.TestCheckResourceAttr(`grafana_team_external_group_item.test["test-group1"]`, "group", "test-group1"),
+				),
+			},
+			{
+				ResourceName:      `grafana_team_external_group_item.test["test-group1"]`,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing an item only removes its group
+			{
+				Config: testAccTeamExternalGroupItemConfig(name, []string{"test-group2"}),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					testAccTeamExternalGroupCheck(&team, []string{"test-group2"}),
+					resource.TestCheckResourceAttr(`grafana_team_external_group_item.test["test-group2"]`, "group", "test-group2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamExternalGroupItem_groupWithColons(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	name := acctest.RandString(10)
+	group := "urn:mace:example:" + name
+	var team models.TeamDTO
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamExternalGroupItemConfig(name, []string{group}),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					testAccTeamExternalGroupCheck(&team, []string{group}),
+					resource.TestCheckResourceAttr(fmt.Sprintf(`grafana_team_external_group_item.test["%s"]`, group), "group", group),
+				),
+			},
+			// The group is the last part of the ID, so it keeps its colons when the ID is parsed
+			{
+				Config:   testAccTeamExternalGroupItemConfig(name, []string{group}),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      fmt.Sprintf(`grafana_team_external_group_item.test["%s"]`, group),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTeamExternalGroupItemConfig(name string, groups []string) string {
+	// The items are keyed by group, so that removing a group doesn't change the other items
+	return fmt.Sprintf(`
+	resource "grafana_team" "test" {
+		name  = "%s"
+	}
+
+	resource "grafana_team_external_group_item" "test" {
+		for_each = toset(["%s"])
+		team_id  = grafana_team.test.id
+		group    = each.value
+	}
+	`, name, strings.Join(groups, `", "`))
+}
@@ -0,0 +1,138 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTeamPreferences() *common.Resource {
+	preferencesSchema := preferencesAttributes("team")
+	preferencesSchema["team_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the team.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			_, old = SplitOrgResourceID(old)
+			_, new = SplitOrgResourceID(new)
+			return old == new
+		},
+	}
+
+	schema := &schema.Resource{
+		Description: `
+Manages the preferences of a team, which apply to its members unless they set their own.
+Equivalent to the ` + "`preferences`" + ` attribute of the ` + "`grafana_team`" + ` resource. Use one or the other to configure a team's preferences.
+Deleting this resource resets the preferences to their defaults.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/organization-preferences/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/team/#update-team-preferences)
+`,
+
+		CreateContext: UpdateTeamPreferences,
+		ReadContext:   ReadTeamPreferences,
+		UpdateContext: UpdateTeamPreferences,
+		DeleteContext: DeleteTeamPreferences,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: preferencesSchema,
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_team_preferences",
+		orgResourceIDInt("teamID"),
+		schema,
+	)
+}
+
+// preferencesAttributes returns the attributes shared by the preferences resources.
+func preferencesAttributes(subject string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"theme": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The default theme for this " + subject + ". Available values are `light`, `dark`, `system`, or an empty string for the default.",
+			ValidateFunc: validation.StringInSlice([]string{"light", "dark", "system", ""}, false),
+		},
+		"home_dashboard_uid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The UID of the dashboard to display when the " + subject + " logs in.",
+		},
+		"timezone": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The default timezone for this " + subject + ". Available values are `utc`, `browser`, or an empty string for the default.",
+			ValidateFunc: validation.StringInSlice([]string{"utc", "browser", ""}, false),
+		},
+		"week_start": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The default week start day for this " + subject + ". Available values are `sunday`, `monday`, `saturday`, or an empty string for the default.",
+			ValidateFunc: validation.StringInSlice([]string{"sunday", "monday", "saturday", ""}, false),
+		},
+	}
+}
+
+func preferencesFromResourceData(d *schema.ResourceData) *models.UpdatePrefsCmd {
+	return &models.UpdatePrefsCmd{
+		Theme:            d.Get("theme").(string),
+		HomeDashboardUID: d.Get("home_dashboard_uid").(string),
+		Timezone:         d.Get("timezone").(string),
+		WeekStart:        d.Get("week_start").(string),
+	}
+}
+
+func setPreferencesResourceData(d *schema.ResourceData, prefs *models.Preferences) {
+	d.Set("theme", prefs.Theme)
+	d.Set("home_dashboard_uid", prefs.HomeDashboardUID)
+	d.Set("timezone", prefs.Timezone)
+	d.Set("week_start", prefs.WeekStart)
+}
+
+func UpdateTeamPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, teamIDStr := SplitOrgResourceID(d.Get("team_id").(string))
+	teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(MakeOrgResourceID(orgID, teamID))
+	client, _, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	if _, err := client.Teams.UpdateTeamPreferences(teamIDStr, preferencesFromResourceData(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadTeamPreferences(ctx, d, meta)
+}
+
+func ReadTeamPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, teamIDStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	resp, err := client.Teams.GetTeamPreferences(teamIDStr)
+	if err, shouldReturn := common.CheckReadError("team preferences", d, err); shouldReturn {
+		return err
+	}
+
+	setPreferencesResourceData(d, resp.Payload)
+	d.Set("team_id", MakeOrgResourceID(orgID, teamIDStr))
+
+	return nil
+}
+
+func DeleteTeamPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, teamIDStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	_, err := client.Teams.UpdateTeamPreferences(teamIDStr, &models.UpdatePrefsCmd{})
+	diag, _ := common.CheckReadError("team preferences", d, err)
+	return diag
+}
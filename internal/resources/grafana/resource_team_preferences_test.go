@@ -0,0 +1,58 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTeamPreferences_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	var team models.TeamDTO
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_team_preferences/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.team", &team),
+					resource.TestCheckResourceAttr("grafana_team_preferences.team_preferences", "theme", "dark"),
+					resource.TestCheckResourceAttr("grafana_team_preferences.team_preferences", "timezone", "browser"),
+					resource.TestCheckResourceAttr("grafana_team_preferences.team_preferences", "week_start", "monday"),
+					resource.TestCheckResourceAttr("grafana_team_preferences.team_preferences", "home_dashboard_uid", "team-metrics"),
+				),
+			},
+			{
+				ResourceName:      "grafana_team_preferences.team_preferences",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Deleting the resource resets the preferences
+			{
+				Config: testutils.WithoutResource(t, testutils.TestAccExample(t, "resources/grafana_team_preferences/resource.tf"), "grafana_team_preferences.team_preferences"),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.team", &team),
+					func(s *terraform.State) error {
+						client := grafanaTestClient().WithOrgID(team.OrgID)
+						resp, err := client.Teams.GetTeamPreferences(strconv.FormatInt(team.ID, 10))
+						if err != nil {
+							return err
+						}
+						if prefs := resp.Payload; prefs.Theme != "" || prefs.Timezone != "" || prefs.WeekStart != "" || prefs.HomeDashboardUID != "" {
+							return fmt.Errorf("expected the team preferences to be reset, got %+v", prefs)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
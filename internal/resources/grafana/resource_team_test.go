@@ -52,6 +52,19 @@ func TestAccTeam_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"ignore_externally_synced_members"},
 			},
+			{
+				ResourceName:            "grafana_team.test",
+				ImportState:             true,
+				ImportStateId:           "name:" + teamNameUpdated,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_externally_synced_members"},
+			},
+			{
+				ResourceName:  "grafana_team.test",
+				ImportState:   true,
+				ImportStateId: "name:" + teamNameUpdated + "-does-not-exist",
+				ExpectError:   regexp.MustCompile(`no team named`),
+			},
 		},
 	})
 }
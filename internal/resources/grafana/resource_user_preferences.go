@@ -0,0 +1,120 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserPreferences() *common.Resource {
+	preferencesSchema := preferencesAttributes("user")
+	preferencesSchema["org_id"] = orgIDAttribute()
+	preferencesSchema["user_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The ID of the user.",
+	}
+
+	schema := &schema.Resource{
+		Description: `
+Manages the preferences, in an organization, of the user that the provider is authenticated as (with basic auth).
+Grafana only allows users to change their own preferences, so managing the preferences of other users requires a provider configured with their credentials.
+Deleting this resource resets the preferences to their defaults.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/user-management/user-preferences/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/preferences/#update-current-user-prefs)
+`,
+
+		CreateContext: UpdateUserPreferences,
+		ReadContext:   ReadUserPreferences,
+		UpdateContext: UpdateUserPreferences,
+		DeleteContext: DeleteUserPreferences,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: preferencesSchema,
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_user_preferences",
+		orgResourceIDInt("userID"),
+		schema,
+	)
+}
+
+func UpdateUserPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	if d.Id() != "" {
+		client, orgID, _ = OAPIClientFromExistingOrgResource(meta, d.Id())
+	}
+
+	userID, err := signedInUserID(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UserPreferences.UpdateUserPreferences(preferencesFromResourceData(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, userID))
+	return ReadUserPreferences(ctx, d, meta)
+}
+
+func ReadUserPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	userID, err := signedInUserID(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.UserPreferences.GetUserPreferences()
+	if err, shouldReturn := common.CheckReadError("user preferences", d, err); shouldReturn {
+		return err
+	}
+
+	setPreferencesResourceData(d, resp.Payload)
+	d.Set("user_id", userID)
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+
+	return nil
+}
+
+func DeleteUserPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	if _, err := signedInUserID(client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err := client.UserPreferences.UpdateUserPreferences(&models.UpdatePrefsCmd{})
+	return diag.FromErr(err)
+}
+
+// signedInUserID returns the ID of the user that the client is authenticated as.
+// If the resource already exists, it checks that the user is the one whose preferences are managed.
+func signedInUserID(client *goapi.GrafanaHTTPAPI, id string) (int64, error) {
+	resp, err := client.SignedInUser.GetSignedInUser()
+	if err != nil {
+		return 0, err
+	}
+	userID := resp.Payload.ID
+
+	if id != "" {
+		_, expectedUserID := SplitOrgResourceID(id)
+		if expectedUserID != strconv.FormatInt(userID, 10) {
+			return 0, fmt.Errorf("the preferences of user %s can only be managed when authenticated as that user, the provider is authenticated as user %d", expectedUserID, userID)
+		}
+	}
+
+	return userID, nil
+}
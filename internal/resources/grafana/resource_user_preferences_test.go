@@ -0,0 +1,34 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserPreferences_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_user_preferences/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_user_preferences.current_user", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_user_preferences.current_user", "user_id", "1"), // The admin user
+					resource.TestCheckResourceAttr("grafana_user_preferences.current_user", "theme", "light"),
+					resource.TestCheckResourceAttr("grafana_user_preferences.current_user", "timezone", "browser"),
+					resource.TestCheckResourceAttr("grafana_user_preferences.current_user", "week_start", "sunday"),
+					resource.TestCheckResourceAttr("grafana_user_preferences.current_user", "home_dashboard_uid", "user-home"),
+				),
+			},
+			{
+				ResourceName:      "grafana_user_preferences.current_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -85,16 +85,23 @@ func addValidationToResources(resources ...*common.Resource) []*common.Resource
 }
 
 var DataSources = addValidationToDataSources(
+	datasourceAnnotations(),
 	datasourceDashboard(),
 	datasourceDashboards(),
 	datasourceDatasource(),
+	datasourceDatasources(),
 	datasourceFolder(),
 	datasourceFolders(),
+	datasourceFolderTree(),
 	datasourceLibraryPanel(),
 	datasourceLibraryPanels(),
+	datasourceLibraryPanelConnections(),
+	datasourceMuteTiming(),
+	datasourceAlertingTemplatePreview(),
 	datasourceUser(),
 	datasourceUsers(),
 	datasourceRole(),
+	datasourceRoles(),
 	datasourceServiceAccount(),
 	datasourceTeam(),
 	datasourceOrganization(),
@@ -104,23 +111,31 @@ var DataSources = addValidationToDataSources(
 var Resources = addValidationToResources(
 	makeResourceFolderPermissionItem(),
 	makeResourceDashboardPermissionItem(),
+	makeResourceDashboardSnapshot(),
 	makeResourceDatasourcePermissionItem(),
 	makeResourceRoleAssignmentItem(),
+	makeResourceTeamExternalGroupItem(),
 	makeResourceServiceAccountPermissionItem(),
 	resourceAnnotation(),
+	resourceAppPluginSettings(),
 	resourceContactPoint(),
 	resourceDashboard(),
 	resourcePublicDashboard(),
 	resourceDashboardPermission(),
 	resourceDataSource(),
 	resourceDataSourceConfig(),
+	resourceDataSourceConfigLBACRules(),
+	resourceDataSourceCorrelation(),
 	resourceDatasourcePermission(),
 	resourceFolder(),
 	resourceFolderPermission(),
 	resourceLibraryPanel(),
 	resourceMessageTemplate(),
 	resourceMuteTiming(),
+	resourceSilence(),
+	resourceAlertingAdminConfig(),
 	resourceNotificationPolicy(),
+	resourceNotificationPolicyRoute(),
 	resourceOrganization(),
 	resourceOrganizationPreferences(),
 	resourcePlaylist(),
@@ -128,11 +143,15 @@ var Resources = addValidationToResources(
 	resourceRole(),
 	resourceRoleAssignment(),
 	resourceRuleGroup(),
+	resourceRuleGroupConfig(),
 	resourceTeam(),
+	resourceTeamPreferences(),
 	resourceTeamExternalGroup(),
 	resourceServiceAccountToken(),
 	resourceServiceAccount(),
 	resourceServiceAccountPermission(),
 	resourceSSOSettings(),
+	resourceSCIMSettings(),
 	resourceUser(),
+	resourceUserPreferences(),
 )
@@ -0,0 +1,123 @@
+package machinelearning
+
+import (
+	"context"
+	"sort"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJobs() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the machine learning jobs, optionally filtered by name or metric.
+`,
+		ReadContext: checkClient(dataSourceJobsRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Only return the jobs with this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"metric": {
+				Description: "Only return the jobs with this metric.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "The IDs of the matching jobs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"jobs": {
+				Description: "The matching jobs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metric": {
+							Description: "The metric used to query the job results.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A description of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"datasource_uid": {
+							Description: "The uid of the datasource to query.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"datasource_type": {
+							Description: "The type of datasource being queried.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hyper_params": {
+							Description: "The hyperparameters used to fine tune the algorithm.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+						},
+						"custom_labels": {
+							Description: "The custom labels added on the forecast.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryMachineLearning, "grafana_machine_learning_jobs", schema)
+}
+
+func dataSourceJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client).MLAPI
+	jobs, err := c.Jobs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	name, metric := d.Get("name").(string), d.Get("metric").(string)
+	ids := []string{}
+	matches := []interface{}{}
+	for _, job := range jobs {
+		if (name != "" && job.Name != name) || (metric != "" && job.Metric != metric) {
+			continue
+		}
+		ids = append(ids, job.ID)
+		matches = append(matches, map[string]interface{}{
+			"id":              job.ID,
+			"name":            job.Name,
+			"metric":          job.Metric,
+			"description":     job.Description,
+			"datasource_uid":  job.DatasourceUID,
+			"datasource_type": job.DatasourceType,
+			"hyper_params":    job.HyperParams,
+			"custom_labels":   job.CustomLabels,
+		})
+	}
+
+	d.SetId("machine_learning_jobs")
+	d.Set("ids", ids)
+	d.Set("jobs", matches)
+	return nil
+}
@@ -0,0 +1,47 @@
+package machinelearning_test
+
+import (
+	"testing"
+
+	"github.com/grafana/machine-learning-go-client/mlapi"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceJobs(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	randomName := acctest.RandomWithPrefix("Test Job")
+	randomMetric := "tf_test_" + acctest.RandString(8)
+	replacements := map[string]string{
+		"Test Job":           randomName,
+		"tf_test_job":        randomMetric,
+		"prometheus-ds-test": "prometheus-ds-" + acctest.RandString(8),
+	}
+
+	var job mlapi.Job
+	jobConfig := testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_job/tuned_job.tf", replacements)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccMLJobCheckDestroy(&job),
+		Steps: []resource.TestStep{
+			{
+				Config: jobConfig,
+				Check:  testAccMLJobCheckExists("grafana_machine_learning_job.test_job", &job),
+			},
+			{
+				// The job must exist when the data source is read, to know the alerts to create
+				Config: jobConfig + testutils.TestAccExampleWithReplace(t, "data-sources/grafana_machine_learning_jobs/data-source.tf", replacements),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_jobs.active_users", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.grafana_machine_learning_jobs.active_users", "ids.0", "grafana_machine_learning_job.test_job", "id"),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_jobs.active_users", "jobs.0.name", randomName),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_jobs.active_users", "jobs.0.metric", randomMetric),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_jobs.active_users", "jobs.0.hyper_params.daily_seasonality", "15"),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_jobs.active_users", "jobs.0.custom_labels.example_label", "example_value"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package machinelearning
+
+import (
+	"context"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOutlierDetector() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Looks up an outlier detector by name or metric.
+
+Visit https://grafana.com/docs/grafana-cloud/machine-learning/outlier-detection/ for more details.
+`,
+		ReadContext: checkClient(dataSourceOutlierDetectorRead),
+		Schema: common.CloneResourceSchemaForDatasource(resourceOutlierDetector().Schema, map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the outlier detector.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "metric"},
+			},
+			"metric": {
+				Description:  "The metric used to query the outlier detector results.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "metric"},
+			},
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryMachineLearning, "grafana_machine_learning_outlier_detector", schema)
+}
+
+func dataSourceOutlierDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client).MLAPI
+	outliers, err := c.OutlierDetectors(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name, metric := d.Get("name").(string), d.Get("metric").(string)
+	var ids []string
+	for _, outlier := range outliers {
+		if (name == "" || outlier.Name == name) && (metric == "" || outlier.Metric == metric) {
+			ids = append(ids, outlier.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return diag.Errorf("couldn't find an outlier detector matching name %q and metric %q", name, metric)
+	} else if len(ids) != 1 {
+		return diag.Errorf("more than one outlier detector found matching name %q and metric %q", name, metric)
+	}
+
+	d.SetId(ids[0])
+	return resourceOutlierRead(ctx, d, meta)
+}
@@ -0,0 +1,49 @@
+package machinelearning_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/machine-learning-go-client/mlapi"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOutlierDetector(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	randomName := acctest.RandomWithPrefix("Outlier Detector")
+	randomMetric := "tf_test_" + acctest.RandString(8)
+	replacements := map[string]string{
+		"My MAD outlier detector": randomName,
+		"tf_test_mad_job":         randomMetric,
+	}
+
+	var outlier mlapi.OutlierDetector
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccMLOutlierCheckDestroy(&outlier),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_outlier_detector/mad.tf", replacements) +
+					testutils.TestAccExampleWithReplace(t, "data-sources/grafana_machine_learning_outlier_detector/data-source.tf", replacements),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMLOutlierCheckExists("grafana_machine_learning_outlier_detector.my_mad_outlier_detector", &outlier),
+					resource.TestCheckResourceAttrPair("data.grafana_machine_learning_outlier_detector.by_name", "id", "grafana_machine_learning_outlier_detector.my_mad_outlier_detector", "id"),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_outlier_detector.by_name", "metric", randomMetric),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_outlier_detector.by_name", "algorithm.0.name", "mad"),
+					resource.TestCheckResourceAttrPair("data.grafana_machine_learning_outlier_detector.by_metric", "id", "grafana_machine_learning_outlier_detector.my_mad_outlier_detector", "id"),
+					resource.TestCheckResourceAttr("data.grafana_machine_learning_outlier_detector.by_metric", "name", randomName),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_outlier_detector/mad.tf", replacements) + `
+data "grafana_machine_learning_outlier_detector" "missing" {
+  name = "does not exist"
+}`,
+				ExpectError: regexp.MustCompile(`couldn't find an outlier detector`),
+			},
+		},
+	})
+}
@@ -29,7 +29,10 @@ func checkClient(f func(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 }
 
-var DataSources = []*common.DataSource{}
+var DataSources = []*common.DataSource{
+	dataSourceJobs(),
+	dataSourceOutlierDetector(),
+}
 
 var Resources = []*common.Resource{
 	resourceJob(),
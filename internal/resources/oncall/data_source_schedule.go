@@ -27,6 +27,14 @@ func dataSourceSchedule() *common.DataSource {
 				Computed:    true,
 				Description: "The schedule type.",
 			},
+			"on_call_now": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the users that are currently on call. Use the `grafana_oncall_schedule_final_shifts` data source to get the shifts of a time window.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 	return common.NewLegacySDKDataSource(common.CategoryOnCall, "grafana_oncall_schedule", schema)
@@ -53,6 +61,7 @@ func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, client
 
 	d.SetId(schedule.ID)
 	d.Set("type", schedule.Type)
+	d.Set("on_call_now", schedule.OnCallNow)
 
 	return nil
 }
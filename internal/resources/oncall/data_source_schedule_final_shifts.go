@@ -0,0 +1,167 @@
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	dataSourceScheduleFinalShiftsName = "grafana_oncall_schedule_final_shifts"
+	scheduleFinalShiftsDateRegexp     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+func dataSourceScheduleFinalShifts() *common.DataSource {
+	return common.NewDataSource(common.CategoryOnCall, dataSourceScheduleFinalShiftsName, &scheduleFinalShiftsDataSource{})
+}
+
+type scheduleFinalShiftsDataSource struct {
+	basePluginFrameworkDataSource
+}
+
+func (r *scheduleFinalShiftsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dataSourceScheduleFinalShiftsName
+}
+
+func (r *scheduleFinalShiftsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(scheduleFinalShiftsDateRegexp, "must be a date in the YYYY-MM-DD format"),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Lists the final shifts of a schedule (with the overrides applied) for a time window.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/manage/on-call-schedules/)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/schedules/#export-a-schedules-final-shifts)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"schedule_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the schedule.",
+			},
+			"start_date": schema.StringAttribute{
+				Required:    true,
+				Description: "The start date of the time window, in the YYYY-MM-DD format.",
+				Validators:  dateValidators,
+			},
+			"end_date": schema.StringAttribute{
+				Required:    true,
+				Description: "The end date of the time window (inclusive), in the YYYY-MM-DD format.",
+				Validators:  dateValidators,
+			},
+			"shifts": schema.ListAttribute{
+				Description: "The final shifts of the schedule, ordered by start time.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"user_id":       types.StringType,
+						"user_email":    types.StringType,
+						"user_username": types.StringType,
+						"shift_start":   types.StringType,
+						"shift_end":     types.StringType,
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+type scheduleFinalShiftDataSourceModel struct {
+	UserID       basetypes.StringValue `tfsdk:"user_id"`
+	UserEmail    basetypes.StringValue `tfsdk:"user_email"`
+	UserUsername basetypes.StringValue `tfsdk:"user_username"`
+	ShiftStart   basetypes.StringValue `tfsdk:"shift_start"`
+	ShiftEnd     basetypes.StringValue `tfsdk:"shift_end"`
+}
+
+type scheduleFinalShiftsDataSourceModel struct {
+	ID         basetypes.StringValue               `tfsdk:"id"`
+	ScheduleID basetypes.StringValue               `tfsdk:"schedule_id"`
+	StartDate  basetypes.StringValue               `tfsdk:"start_date"`
+	EndDate    basetypes.StringValue               `tfsdk:"end_date"`
+	Shifts     []scheduleFinalShiftDataSourceModel `tfsdk:"shifts"`
+}
+
+// The final shifts endpoint isn't supported by the OnCall client
+type listScheduleFinalShiftsOptions struct {
+	onCallAPI.ListOptions
+	StartDate string `url:"start_date"`
+	EndDate   string `url:"end_date"`
+}
+
+type scheduleFinalShift struct {
+	UserPk       string `json:"user_pk"`
+	UserEmail    string `json:"user_email"`
+	UserUsername string `json:"user_username"`
+	ShiftStart   string `json:"shift_start"`
+	ShiftEnd     string `json:"shift_end"`
+}
+
+type paginatedScheduleFinalShiftsResponse struct {
+	onCallAPI.PaginatedResponse
+	Shifts []scheduleFinalShift `json:"results"`
+}
+
+func (r *scheduleFinalShiftsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform state data into the model
+	var data scheduleFinalShiftsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleID := data.ScheduleID.ValueString()
+	allShifts := []scheduleFinalShiftDataSourceModel{}
+	for page := 1; ; page++ {
+		options := &listScheduleFinalShiftsOptions{
+			ListOptions: onCallAPI.ListOptions{
+				Page: page,
+			},
+			StartDate: data.StartDate.ValueString(),
+			EndDate:   data.EndDate.ValueString(),
+		}
+		httpReq, err := r.client.NewRequest("GET", fmt.Sprintf("schedules/%s/final_shifts/", scheduleID), options)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create request", err.Error())
+			return
+		}
+		var shiftsResponse paginatedScheduleFinalShiftsResponse
+		if _, err := r.client.Do(httpReq, &shiftsResponse); err != nil {
+			resp.Diagnostics.AddError("Failed to list the final shifts of the schedule", err.Error())
+			return
+		}
+
+		for _, shift := range shiftsResponse.Shifts {
+			allShifts = append(allShifts, scheduleFinalShiftDataSourceModel{
+				UserID:       basetypes.NewStringValue(shift.UserPk),
+				UserEmail:    basetypes.NewStringValue(shift.UserEmail),
+				UserUsername: basetypes.NewStringValue(shift.UserUsername),
+				ShiftStart:   basetypes.NewStringValue(shift.ShiftStart),
+				ShiftEnd:     basetypes.NewStringValue(shift.ShiftEnd),
+			})
+		}
+
+		if shiftsResponse.Next == nil {
+			break
+		}
+	}
+
+	data.ID = basetypes.NewStringValue(fmt.Sprintf("%s:%s:%s", scheduleID, data.StartDate.ValueString(), data.EndDate.ValueString()))
+	data.Shifts = allShifts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,56 @@
+package oncall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceScheduleFinalShifts_Basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	scheduleName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceScheduleFinalShiftsConfig(scheduleName, "2024/06/03"),
+				ExpectError: regexp.MustCompile(`must be a date in the YYYY-MM-DD format`),
+			},
+			{
+				Config: testAccDataSourceScheduleFinalShiftsConfig(scheduleName, "2024-06-03"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.grafana_oncall_schedule_final_shifts.test", "schedule_id", "grafana_oncall_schedule.test", "id"),
+					// The schedule has no shifts
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule_final_shifts.test", "shifts.#", "0"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule.test", "on_call_now.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceScheduleFinalShiftsConfig(scheduleName, startDate string) string {
+	return fmt.Sprintf(`
+resource "grafana_oncall_schedule" "test" {
+	name = "%s"
+	type = "calendar"
+	time_zone = "America/New_York"
+}
+
+data "grafana_oncall_schedule" "test" {
+	name = grafana_oncall_schedule.test.name
+}
+
+data "grafana_oncall_schedule_final_shifts" "test" {
+	schedule_id = grafana_oncall_schedule.test.id
+	start_date  = "%s"
+	end_date    = "2024-06-09"
+}
+`, scheduleName, startDate)
+}
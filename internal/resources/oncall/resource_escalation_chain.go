@@ -2,23 +2,34 @@ package oncall
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceEscalationChain() *common.Resource {
 	schema := &schema.Resource{
 		Description: `
+The steps of the escalation chain can either be managed inline with ` + "`step`" + ` blocks or with separate ` + "`grafana_oncall_escalation`" + ` resources, but not both.
+When ` + "`step`" + ` blocks are used, the positions of the steps are given by the order of the blocks and all the steps of the chain are reconciled by this resource.
+Removing all the ` + "`step`" + ` blocks stops managing the steps, it doesn't delete them.
+
+The steps are not reconciled atomically: the existing steps are updated in place first, then the missing steps are created and the extra steps are deleted last, so the chain stays valid if a request fails midway.
+In that case, the steps are read back from OnCall so that the next plan shows the remaining changes.
+
 * [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/escalation_chains/)
 `,
 		CreateContext: withClient[schema.CreateContextFunc](resourceEscalationChainCreate),
 		ReadContext:   withClient[schema.ReadContextFunc](resourceEscalationChainRead),
 		UpdateContext: withClient[schema.UpdateContextFunc](resourceEscalationChainUpdate),
 		DeleteContext: withClient[schema.DeleteContextFunc](resourceEscalationChainDelete),
+		CustomizeDiff: resourceEscalationChainCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,6 +45,12 @@ func resourceEscalationChain() *common.Resource {
 				Optional:    true,
 				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource.",
 			},
+			"step": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The ordered steps of the escalation chain. If no steps are set, the steps of the chain are not managed by this resource.",
+				Elem:        escalationStepSchema(),
+			},
 		},
 	}
 
@@ -74,6 +91,10 @@ func resourceEscalationChainCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(escalationChain.ID)
 
+	if err := reconcileEscalationChainSteps(client, d.Id(), d.Get("step").([]interface{})); err != nil {
+		return append(resourceEscalationChainRead(ctx, d, client), diag.FromErr(err)...)
+	}
+
 	return resourceEscalationChainRead(ctx, d, client)
 }
 
@@ -89,6 +110,15 @@ func resourceEscalationChainRead(ctx context.Context, d *schema.ResourceData, cl
 	d.Set("name", escalationChain.Name)
 	d.Set("team_id", escalationChain.TeamId)
 
+	// The steps are only read if they are managed by this resource
+	if len(d.Get("step").([]interface{})) > 0 {
+		escalations, err := listEscalationChainSteps(client, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("step", flattenEscalationChainSteps(escalations))
+	}
+
 	return nil
 }
 
@@ -107,6 +137,14 @@ func resourceEscalationChainUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(escalationChain.ID)
+
+	// Without step blocks, the steps are not managed by this resource, even if they were before
+	if steps := d.Get("step").([]interface{}); d.HasChange("step") && len(steps) > 0 {
+		if err := reconcileEscalationChainSteps(client, d.Id(), steps); err != nil {
+			return append(resourceEscalationChainRead(ctx, d, client), diag.FromErr(err)...)
+		}
+	}
+
 	return resourceEscalationChainRead(ctx, d, client)
 }
 
@@ -114,3 +152,285 @@ func resourceEscalationChainDelete(ctx context.Context, d *schema.ResourceData,
 	_, err := client.EscalationChains.DeleteEscalationChain(d.Id(), &onCallAPI.DeleteEscalationChainOptions{})
 	return diag.FromErr(err)
 }
+
+func resourceEscalationChainCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, raw := range d.Get("step").([]interface{}) {
+		step, _ := raw.(map[string]interface{})
+		if err := validateEscalationStep(step); err != nil {
+			return fmt.Errorf("invalid step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateEscalationStep checks that the fields of the step can be set with its type.
+func validateEscalationStep(step map[string]interface{}) error {
+	stepType, _ := step["type"].(string)
+	for field, fieldType := range escalationStepFieldTypes {
+		if isEscalationStepFieldSet(step[field]) && fieldType != stepType {
+			return fmt.Errorf("%s can not be set with type: %s", field, stepType)
+		}
+	}
+	return nil
+}
+
+// escalationStepFieldTypes maps the escalation step fields to the type of step that they can be set with.
+var escalationStepFieldTypes = map[string]string{
+	"duration":                         "wait",
+	"notify_on_call_from_schedule":     "notify_on_call_from_schedule",
+	"persons_to_notify":                "notify_persons",
+	"persons_to_notify_next_each_time": "notify_person_next_each_time",
+	"notify_to_team_members":           "notify_team_members",
+	"action_to_trigger":                "trigger_webhook",
+	"group_to_notify":                  "notify_user_group",
+	"notify_if_time_from":              "notify_if_time_from_to",
+	"notify_if_time_to":                "notify_if_time_from_to",
+}
+
+func escalationStepSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the escalation step.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(escalationOptions, false),
+				Description:  fmt.Sprintf("The type of escalation policy. Can be %s", escalationOptionsVerbal),
+			},
+			"important": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Will activate \"important\" personal notification rules. Actual for steps: notify_persons, notify_on_call_from_schedule and notify_user_group,notify_team_members",
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(durationOptions),
+				Description:  "The duration of delay for wait type step.",
+			},
+			"notify_on_call_from_schedule": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of a Schedule for notify_on_call_from_schedule type step.",
+			},
+			"persons_to_notify": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The list of ID's of users for notify_persons type step.",
+			},
+			"persons_to_notify_next_each_time": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The list of ID's of users for notify_person_next_each_time type step.",
+			},
+			"notify_to_team_members": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of a Team for a notify_team_members type step.",
+			},
+			"action_to_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of an Action for trigger_webhook type step.",
+			},
+			"group_to_notify": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of a User Group for notify_user_group type step.",
+			},
+			"notify_if_time_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The beginning of the time interval for notify_if_time_from_to type step in UTC (for example 08:00:00Z).",
+			},
+			"notify_if_time_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the time interval for notify_if_time_from_to type step in UTC (for example 18:00:00Z).",
+			},
+		},
+	}
+}
+
+// The escalation chain filter of the escalation policies endpoint isn't supported by the OnCall client
+type listEscalationChainStepsOptions struct {
+	onCallAPI.ListOptions
+	EscalationChainID string `url:"escalation_chain_id"`
+}
+
+// listEscalationChainSteps returns the steps of an escalation chain, ordered by position.
+func listEscalationChainSteps(client *onCallAPI.Client, escalationChainID string) ([]*onCallAPI.Escalation, error) {
+	var escalations []*onCallAPI.Escalation
+	for page := 1; ; page++ {
+		options := &listEscalationChainStepsOptions{
+			ListOptions:       onCallAPI.ListOptions{Page: page},
+			EscalationChainID: escalationChainID,
+		}
+		req, err := client.NewRequest(http.MethodGet, "escalation_policies/", options)
+		if err != nil {
+			return nil, err
+		}
+		var resp onCallAPI.PaginatedEscalationsResponse
+		if _, err := client.Do(req, &resp); err != nil {
+			return nil, err
+		}
+		for _, escalation := range resp.Escalations {
+			// Filter again in case the API ignores the filter
+			if escalation.EscalationChainId == escalationChainID {
+				escalations = append(escalations, escalation)
+			}
+		}
+		if resp.Next == nil {
+			break
+		}
+	}
+
+	sort.Slice(escalations, func(i, j int) bool {
+		return escalations[i].Position < escalations[j].Position
+	})
+	return escalations, nil
+}
+
+// reconcileEscalationChainSteps updates the existing steps of the chain in place, then creates the missing steps and deletes the extra ones.
+// The extra steps are deleted last so that the chain never has fewer steps than configured if a request fails.
+func reconcileEscalationChainSteps(client *onCallAPI.Client, escalationChainID string, steps []interface{}) error {
+	existing, err := listEscalationChainSteps(client, escalationChainID)
+	if err != nil {
+		return err
+	}
+
+	for i, raw := range steps {
+		step, _ := raw.(map[string]interface{})
+		options := expandEscalationStep(step)
+		position := i
+		options.Position = &position
+		options.ManualOrder = true
+
+		if i < len(existing) {
+			updateOptions := &onCallAPI.UpdateEscalationOptions{
+				Position:                 options.Position,
+				Type:                     options.Type,
+				Duration:                 options.Duration,
+				PersonsToNotify:          options.PersonsToNotify,
+				PersonsToNotifyEachTime:  options.PersonsToNotifyNextEachTime,
+				TeamToNotify:             options.TeamToNotify,
+				NotifyOnCallFromSchedule: options.NotifyOnCallFromSchedule,
+				ActionToTrigger:          options.ActionToTrigger,
+				GroupToNotify:            options.GroupToNotify,
+				ManualOrder:              options.ManualOrder,
+				Important:                options.Important,
+				NotifyIfTimeFrom:         options.NotifyIfTimeFrom,
+				NotifyIfTimeTo:           options.NotifyIfTimeTo,
+			}
+			if _, _, err := client.Escalations.UpdateEscalation(existing[i].ID, updateOptions); err != nil {
+				return fmt.Errorf("error updating step %d: %w", i, err)
+			}
+			continue
+		}
+
+		options.EscalationChainId = escalationChainID
+		if _, _, err := client.Escalations.CreateEscalation(options); err != nil {
+			return fmt.Errorf("error creating step %d: %w", i, err)
+		}
+	}
+
+	for i := len(steps); i < len(existing); i++ {
+		if _, err := client.Escalations.DeleteEscalation(existing[i].ID, &onCallAPI.DeleteEscalationOptions{}); err != nil {
+			return fmt.Errorf("error deleting step %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func expandEscalationStep(step map[string]interface{}) *onCallAPI.CreateEscalationOptions {
+	stepType, _ := step["type"].(string)
+	important, _ := step["important"].(bool)
+	options := &onCallAPI.CreateEscalationOptions{
+		Type:      &stepType,
+		Important: &important,
+	}
+	options.Duration, _ = step["duration"].(int)
+	options.NotifyOnCallFromSchedule, _ = step["notify_on_call_from_schedule"].(string)
+	options.TeamToNotify, _ = step["notify_to_team_members"].(string)
+	options.ActionToTrigger, _ = step["action_to_trigger"].(string)
+	options.GroupToNotify, _ = step["group_to_notify"].(string)
+	options.NotifyIfTimeFrom, _ = step["notify_if_time_from"].(string)
+	options.NotifyIfTimeTo, _ = step["notify_if_time_to"].(string)
+	if persons, ok := step["persons_to_notify"].(*schema.Set); ok && persons.Len() > 0 {
+		personsSlice := common.SetToStringSlice(persons)
+		options.PersonsToNotify = &personsSlice
+	}
+	if persons, ok := step["persons_to_notify_next_each_time"].(*schema.Set); ok && persons.Len() > 0 {
+		personsSlice := common.SetToStringSlice(persons)
+		options.PersonsToNotifyNextEachTime = &personsSlice
+	}
+
+	return options
+}
+
+func isEscalationStepFieldSet(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case *schema.Set:
+		return v.Len() > 0
+	}
+	return false
+}
+
+func flattenEscalationChainSteps(escalations []*onCallAPI.Escalation) []interface{} {
+	steps := make([]interface{}, 0, len(escalations))
+	for _, escalation := range escalations {
+		step := map[string]interface{}{
+			"id": escalation.ID,
+		}
+		if escalation.Type != nil {
+			step["type"] = *escalation.Type
+		}
+		if escalation.Duration != nil {
+			step["duration"] = *escalation.Duration
+		}
+		if escalation.NotifyOnCallFromSchedule != nil {
+			step["notify_on_call_from_schedule"] = *escalation.NotifyOnCallFromSchedule
+		}
+		if escalation.PersonsToNotify != nil {
+			step["persons_to_notify"] = common.StringSliceToSet(*escalation.PersonsToNotify)
+		}
+		if escalation.PersonsToNotifyEachTime != nil {
+			step["persons_to_notify_next_each_time"] = common.StringSliceToSet(*escalation.PersonsToNotifyEachTime)
+		}
+		if escalation.TeamToNotify != nil {
+			step["notify_to_team_members"] = *escalation.TeamToNotify
+		}
+		if escalation.GroupToNotify != nil {
+			step["group_to_notify"] = *escalation.GroupToNotify
+		}
+		if escalation.ActionToTrigger != nil {
+			step["action_to_trigger"] = *escalation.ActionToTrigger
+		}
+		if escalation.Important != nil {
+			step["important"] = *escalation.Important
+		}
+		if escalation.NotifyIfTimeFrom != nil {
+			step["notify_if_time_from"] = *escalation.NotifyIfTimeFrom
+		}
+		if escalation.NotifyIfTimeTo != nil {
+			step["notify_if_time_to"] = *escalation.NotifyIfTimeTo
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
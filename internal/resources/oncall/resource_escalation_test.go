@@ -2,6 +2,7 @@ package oncall_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
@@ -139,3 +140,118 @@ func testAccCheckOnCallEscalationResourceExists(name string) resource.TestCheckF
 		return nil
 	}
 }
+
+func TestAccOnCallEscalationChain_steps(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	chainName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallEscalationChainStepsConfig(chainName, `
+	step {
+		type = "wait"
+		duration = 300
+	}
+	step {
+		type = "repeat_escalation"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.#", "2"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.0.type", "wait"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.0.duration", "300"),
+					resource.TestCheckResourceAttrSet("grafana_oncall_escalation_chain.test", "step.0.id"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.1.type", "repeat_escalation"),
+				),
+			},
+			// Insert a step at the start and remove the last one
+			{
+				Config: testAccOnCallEscalationChainStepsConfig(chainName, `
+	step {
+		type = "notify_team_members"
+		notify_to_team_members = data.grafana_oncall_team.test.id
+		important = true
+	}
+	step {
+		type = "wait"
+		duration = 60
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.#", "2"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.0.type", "notify_team_members"),
+					resource.TestCheckResourceAttrPair("grafana_oncall_escalation_chain.test", "step.0.notify_to_team_members", "data.grafana_oncall_team.test", "id"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.0.important", "true"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.1.type", "wait"),
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.1.duration", "60"),
+				),
+			},
+			{
+				Config: testAccOnCallEscalationChainStepsConfig(chainName, `
+	step {
+		type = "wait"
+		notify_to_team_members = "ABCDEF"
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`notify_to_team_members can not be set with type: wait`),
+			},
+			// Removing the step blocks stops managing the steps, they are kept
+			{
+				Config: testAccOnCallEscalationChainStepsConfig(chainName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_escalation_chain.test", "step.#", "0"),
+					testAccCheckOnCallEscalationChainStepCount("grafana_oncall_escalation_chain.test", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOnCallEscalationChainStepCount(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).OnCallClient
+
+		count := 0
+		for page := 1; ; page++ {
+			resp, _, err := client.Escalations.ListEscalations(&onCallAPI.ListEscalationOptions{ListOptions: onCallAPI.ListOptions{Page: page}})
+			if err != nil {
+				return err
+			}
+			for _, escalation := range resp.Escalations {
+				if escalation.EscalationChainId == rs.Primary.ID {
+					count++
+				}
+			}
+			if resp.Next == nil {
+				break
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d steps in escalation chain %s, got %d", expected, rs.Primary.ID, count)
+		}
+		return nil
+	}
+}
+
+func testAccOnCallEscalationChainStepsConfig(chainName, steps string) string {
+	return fmt.Sprintf(`
+resource "grafana_team" "test" {
+	name = "%[1]s"
+}
+
+data "grafana_oncall_team" "test" {
+	name = grafana_team.test.name
+}
+
+resource "grafana_oncall_escalation_chain" "test" {
+	name = "%[1]s"
+	%[2]s
+}
+`, chainName, steps)
+}
@@ -48,6 +48,8 @@ var integrationTypesVerbal = strings.Join(integrationTypes, ", ")
 func resourceIntegration() *common.Resource {
 	schema := &schema.Resource{
 		Description: `
+The direct paging settings of a team (default escalation chain and chatops channels) are managed with an integration of type ` + "`direct_paging`" + ` that belongs to the team.
+
 * [Official documentation](https://grafana.com/docs/oncall/latest/configure/integrations/)
 * [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/)
 `,
@@ -0,0 +1,173 @@
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	integrationMaintenanceModes     = []string{"maintenance", "debug"}
+	integrationMaintenanceDurations = []string{"1h", "3h", "6h", "12h", "24h"}
+)
+
+func resourceIntegrationMaintenance() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Puts an OnCall integration in maintenance or debug mode for a given duration. Destroying this resource stops the maintenance early.
+
+In maintenance mode, the alerts of the integration are collected in a single alert group and no notifications are sent.
+In debug mode, the alert groups are created but no notifications are sent.
+
+When the maintenance ends, the resource stays in the state with ` + "`active`" + ` set to false. Replace the resource to start a new maintenance.
+This resource cannot be imported.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/configure/integrations/#maintenance-mode)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/integrations/)
+`,
+		CreateContext: withClient[schema.CreateContextFunc](resourceIntegrationMaintenanceCreate),
+		ReadContext:   withClient[schema.ReadContextFunc](resourceIntegrationMaintenanceRead),
+		DeleteContext: withClient[schema.DeleteContextFunc](resourceIntegrationMaintenanceDelete),
+
+		Schema: map[string]*schema.Schema{
+			"integration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the integration.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "maintenance",
+				ValidateFunc: validation.StringInSlice(integrationMaintenanceModes, false),
+				Description:  "The maintenance mode. Can be `maintenance` or `debug`.",
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(integrationMaintenanceDurations, false),
+				Description:  "The duration of the maintenance. Can be `1h`, `3h`, `6h`, `12h` or `24h`.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the maintenance is still in progress.",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the maintenance started.",
+			},
+			"end_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the maintenance ends.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryOnCall,
+		"grafana_oncall_integration_maintenance",
+		nil,
+		schema,
+	)
+}
+
+// The maintenance endpoints and attributes of integrations aren't supported by the OnCall client
+type integrationMaintenance struct {
+	MaintenanceMode      *string `json:"maintenance_mode"`
+	MaintenanceStartedAt *string `json:"maintenance_started_at"`
+	MaintenanceEndAt     *string `json:"maintenance_end_at"`
+}
+
+type startIntegrationMaintenanceOptions struct {
+	Mode     string `json:"mode"`
+	Duration int    `json:"duration"`
+}
+
+func resourceIntegrationMaintenanceCreate(ctx context.Context, d *schema.ResourceData, client *onCallAPI.Client) diag.Diagnostics {
+	integrationID := d.Get("integration_id").(string)
+	duration, _ := time.ParseDuration(d.Get("duration").(string))
+
+	options := &startIntegrationMaintenanceOptions{
+		Mode:     d.Get("mode").(string),
+		Duration: int(duration.Seconds()),
+	}
+	if err := postIntegrationMaintenanceAction(client, integrationID, "maintenance_start", options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(integrationID)
+
+	return resourceIntegrationMaintenanceRead(ctx, d, client)
+}
+
+// The maintenance can't be updated. The state is only removed if the integration has been deleted.
+func resourceIntegrationMaintenanceRead(ctx context.Context, d *schema.ResourceData, client *onCallAPI.Client) diag.Diagnostics {
+	maintenance, r, err := getIntegrationMaintenance(client, d.Id())
+	if err != nil {
+		if r != nil && r.StatusCode == http.StatusNotFound {
+			return common.WarnMissing("integration maintenance", d)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("integration_id", d.Id())
+	d.Set("active", maintenance.MaintenanceMode != nil)
+	if maintenance.MaintenanceMode != nil {
+		d.Set("mode", maintenance.MaintenanceMode)
+		d.Set("started_at", maintenance.MaintenanceStartedAt)
+		d.Set("end_at", maintenance.MaintenanceEndAt)
+	}
+
+	return nil
+}
+
+func resourceIntegrationMaintenanceDelete(ctx context.Context, d *schema.ResourceData, client *onCallAPI.Client) diag.Diagnostics {
+	maintenance, r, err := getIntegrationMaintenance(client, d.Id())
+	if err != nil {
+		if r != nil && r.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if maintenance.MaintenanceMode == nil {
+		// The maintenance has already ended
+		return nil
+	}
+
+	return diag.FromErr(postIntegrationMaintenanceAction(client, d.Id(), "maintenance_stop", nil))
+}
+
+func getIntegrationMaintenance(client *onCallAPI.Client, integrationID string) (*integrationMaintenance, *http.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("integrations/%s/", integrationID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	maintenance := new(integrationMaintenance)
+	resp, err := client.Do(req, maintenance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return maintenance, resp, nil
+}
+
+func postIntegrationMaintenanceAction(client *onCallAPI.Client, integrationID, action string, body interface{}) error {
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("integrations/%s/%s/", integrationID, action), body)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,57 @@
+package oncall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOnCallIntegrationMaintenance_basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	rName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOnCallIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallIntegrationMaintenanceConfig(rName, "maintenance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("grafana_oncall_integration_maintenance.test", "integration_id", "grafana_oncall_integration.test", "id"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration_maintenance.test", "mode", "maintenance"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration_maintenance.test", "active", "true"),
+					resource.TestCheckResourceAttrSet("grafana_oncall_integration_maintenance.test", "started_at"),
+					resource.TestCheckResourceAttrSet("grafana_oncall_integration_maintenance.test", "end_at"),
+				),
+			},
+			// Switching the mode stops the maintenance and starts a new one
+			{
+				Config: testAccOnCallIntegrationMaintenanceConfig(rName, "debug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration_maintenance.test", "mode", "debug"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration_maintenance.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOnCallIntegrationMaintenanceConfig(rName, mode string) string {
+	return fmt.Sprintf(`
+resource "grafana_oncall_integration" "test" {
+	name = "%[1]s"
+	type = "grafana"
+	default_route {}
+}
+
+resource "grafana_oncall_integration_maintenance" "test" {
+	integration_id = grafana_oncall_integration.test.id
+	mode           = "%[2]s"
+	duration       = "1h"
+}
+`, rName, mode)
+}
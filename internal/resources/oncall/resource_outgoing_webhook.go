@@ -2,12 +2,33 @@ package oncall
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	outgoingWebhookTriggerTypes = []string{
+		"escalation",
+		"alert group created",
+		"acknowledge",
+		"resolve",
+		"silence",
+		"unsilence",
+		"unresolve",
+		"unacknowledge",
+		"status change",
+		"personal notification",
+	}
+	outgoingWebhookTriggerTypesVerbal = "`" + strings.Join(outgoingWebhookTriggerTypes, "`, `") + "`"
+
+	outgoingWebhookHTTPMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
 )
 
 func resourceOutgoingWebhook() *common.Resource {
@@ -42,7 +63,7 @@ func resourceOutgoingWebhook() *common.Resource {
 			"data": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The data of the webhook.",
+				Description: "The data of the webhook. This is a Jinja template that is rendered with the event payload, unless `forward_whole_payload` is true.",
 			},
 			"user": {
 				Type:        schema.TypeString,
@@ -67,21 +88,23 @@ func resourceOutgoingWebhook() *common.Resource {
 				Description: "Toggle to send the entire webhook payload instead of using the values in the Data field.",
 			},
 			"trigger_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The type of event that will cause this outgoing webhook to execute. The types of triggers are: `escalation`, `alert group created`, `acknowledge`, `resolve`, `silence`, `unsilence`, `unresolve`, `unacknowledge`.",
-				Default:     "escalation",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  fmt.Sprintf("The type of event that will cause this outgoing webhook to execute. The types of triggers are: %s.", outgoingWebhookTriggerTypesVerbal),
+				Default:      "escalation",
+				ValidateFunc: validation.StringInSlice(outgoingWebhookTriggerTypes, false),
 			},
 			"http_method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The HTTP method used in the request made by the outgoing webhook.",
-				Default:     "POST",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The HTTP method used in the request made by the outgoing webhook. Can be `GET`, `POST`, `PUT`, `DELETE` or `OPTIONS`.",
+				Default:      "POST",
+				ValidateFunc: validation.StringInSlice(outgoingWebhookHTTPMethods, false),
 			},
 			"trigger_template": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "A template used to dynamically determine whether the webhook should execute based on the content of the payload.",
+				Description: "A Jinja template used to dynamically determine whether the webhook should execute based on the content of the payload.",
 			},
 			"headers": {
 				Type:        schema.TypeString,
@@ -94,6 +117,12 @@ func resourceOutgoingWebhook() *common.Resource {
 				Optional:    true,
 				Description: "Restricts the outgoing webhook to only trigger if the event came from a selected integration. If no integrations are selected the outgoing webhook will trigger for any integration.",
 			},
+			"preset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the preset that the webhook is created from. The fields defined by the preset can't be changed.",
+			},
 			"is_webhook_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -193,7 +222,16 @@ func resourceOutgoingWebhookCreate(ctx context.Context, d *schema.ResourceData,
 		createOptions.IntegrationFilter = &integrationFilterSlice
 	}
 
-	outgoingWebhook, _, err := client.Webhooks.CreateWebhook(createOptions)
+	options := &outgoingWebhookOptions{
+		CreateWebhookOptions: createOptions,
+	}
+	if preset, ok := d.GetOk("preset"); ok {
+		p := preset.(string)
+		options.Preset = &p
+	}
+
+	outgoingWebhook := new(outgoingWebhook)
+	_, err := doOutgoingWebhookRequest(client, http.MethodPost, "webhooks/", options, outgoingWebhook)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,7 +242,8 @@ func resourceOutgoingWebhookCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceOutgoingWebhookRead(ctx context.Context, d *schema.ResourceData, client *onCallAPI.Client) diag.Diagnostics {
-	outgoingWebhook, r, err := client.Webhooks.GetWebhook(d.Id(), &onCallAPI.GetWebhookOptions{})
+	outgoingWebhook := new(outgoingWebhook)
+	r, err := doOutgoingWebhookRequest(client, http.MethodGet, fmt.Sprintf("webhooks/%s/", d.Id()), nil, outgoingWebhook)
 	if err != nil {
 		if r != nil && r.StatusCode == http.StatusNotFound {
 			return common.WarnMissing("outgoing webhook", d)
@@ -224,6 +263,7 @@ func resourceOutgoingWebhookRead(ctx context.Context, d *schema.ResourceData, cl
 	d.Set("trigger_template", outgoingWebhook.TriggerTemplate)
 	d.Set("headers", outgoingWebhook.Headers)
 	d.Set("integration_filter", outgoingWebhook.IntegrationFilter)
+	d.Set("preset", outgoingWebhook.Preset)
 
 	return nil
 }
@@ -297,7 +337,17 @@ func resourceOutgoingWebhookUpdate(ctx context.Context, d *schema.ResourceData,
 		updateOptions.IntegrationFilter = &integrationFilterSlice
 	}
 
-	outgoingWebhook, _, err := client.Webhooks.UpdateWebhook(d.Id(), updateOptions)
+	// The update options have the same fields as the create options
+	options := &outgoingWebhookOptions{
+		CreateWebhookOptions: (*onCallAPI.CreateWebhookOptions)(updateOptions),
+	}
+	if preset, ok := d.GetOk("preset"); ok {
+		p := preset.(string)
+		options.Preset = &p
+	}
+
+	outgoingWebhook := new(outgoingWebhook)
+	_, err := doOutgoingWebhookRequest(client, http.MethodPut, fmt.Sprintf("webhooks/%s/", d.Id()), options, outgoingWebhook)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -310,3 +360,26 @@ func resourceOutgoingWebhookDelete(ctx context.Context, d *schema.ResourceData,
 	_, err := client.Webhooks.DeleteWebhook(d.Id(), &onCallAPI.DeleteWebhookOptions{})
 	return diag.FromErr(err)
 }
+
+// The presets of outgoing webhooks aren't supported by the OnCall client
+type outgoingWebhook struct {
+	onCallAPI.Webhook
+	Preset *string `json:"preset"`
+}
+
+type outgoingWebhookOptions struct {
+	*onCallAPI.CreateWebhookOptions
+	Preset *string `json:"preset,omitempty"`
+}
+
+func doOutgoingWebhookRequest(client *onCallAPI.Client, method, path string, options *outgoingWebhookOptions, result *outgoingWebhook) (*http.Response, error) {
+	var body interface{}
+	if options != nil {
+		body = options
+	}
+	req, err := client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req, result)
+}
@@ -22,11 +22,28 @@ func TestAccOnCallOutgoingWebhook_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckOnCallOutgoingWebhookResourceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccOnCallOutgoingWebhookConfig(webhookName),
+				Config: testAccOnCallOutgoingWebhookConfig(webhookName, "escalation"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOnCallOutgoingWebhookResourceExists("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "trigger_type", "escalation"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "trigger_template", "123"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "preset", ""),
 				),
 			},
+			{
+				Config: testAccOnCallOutgoingWebhookConfig(webhookName, "status change"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnCallOutgoingWebhookResourceExists("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "trigger_type", "status change"),
+				),
+			},
+			{
+				ResourceName:      "grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The credentials are not returned by the API
+				ImportStateVerifyIgnore: []string{"password", "authorization_header"},
+			},
 		},
 	})
 }
@@ -45,7 +62,7 @@ func testAccCheckOnCallOutgoingWebhookResourceDestroy(s *terraform.State) error
 	return nil
 }
 
-func testAccOnCallOutgoingWebhookConfig(webhookName string) string {
+func testAccOnCallOutgoingWebhookConfig(webhookName, triggerType string) string {
 	return fmt.Sprintf(`
 resource "grafana_oncall_outgoing_webhook" "test-acc-outgoing_webhook" {
 	name = "%s"
@@ -55,14 +72,14 @@ resource "grafana_oncall_outgoing_webhook" "test-acc-outgoing_webhook" {
 	password = "test"
 	authorization_header = "Authorization"
 	forward_whole_payload = false
-	trigger_type = "escalation"
+	trigger_type = "%s"
 	http_method = "POST"
 	trigger_template = "123"
 	headers = jsonencode({ "test" = "test123" })
 	integration_filter = []
 	is_webhook_enabled = true
 }
-`, webhookName)
+`, webhookName, triggerType)
 }
 
 func testAccCheckOnCallOutgoingWebhookResourceExists(name string) resource.TestCheckFunc {
@@ -61,7 +61,7 @@ func resourceRoute() *common.Resource {
 			"routing_regex": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Python Regex query. Route is chosen for an alert if there is a match inside the alert payload.",
+				Description: "Python Regex query (when `routing_type` is `regex`) or Jinja2 template (when `routing_type` is `jinja2`). Route is chosen for an alert if there is a match inside the alert payload. Jinja2 templates can match on the labels of the alert group (eg. `{{ labels.severity == \"critical\" }}`).",
 			},
 			"slack": {
 				Type:     schema.TypeList,
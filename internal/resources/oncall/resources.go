@@ -78,6 +78,7 @@ func withClient[T schema.CreateContextFunc | schema.UpdateContextFunc | schema.R
 var DataSources = []*common.DataSource{
 	dataSourceEscalationChain(),
 	dataSourceSchedule(),
+	dataSourceScheduleFinalShifts(),
 	dataSourceSlackChannel(),
 	dataSourceOutgoingWebhook(),
 	dataSourceUserGroup(),
@@ -89,6 +90,7 @@ var DataSources = []*common.DataSource{
 
 var Resources = []*common.Resource{
 	resourceIntegration(),
+	resourceIntegrationMaintenance(),
 	resourceRoute(),
 	resourceEscalationChain(),
 	resourceEscalation(),
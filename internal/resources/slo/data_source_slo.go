@@ -12,7 +12,7 @@ import (
 func datasourceSlo() *common.DataSource {
 	schema := &schema.Resource{
 		Description: `
-Datasource for retrieving all SLOs.
+Datasource for retrieving all SLOs. The SLOs can be filtered by labels.
 		
 * [Official documentation](https://grafana.com/docs/grafana-cloud/alerting-and-irm/slo/)
 * [API documentation](https://grafana.com/docs/grafana-cloud/alerting-and-irm/slo/api/)
@@ -20,6 +20,14 @@ Datasource for retrieving all SLOs.
 				`,
 		ReadContext: withClient[schema.ReadContextFunc](datasourceSloRead),
 		Schema: map[string]*schema.Schema{
+			"label_filter": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `If set, only the SLOs that have all of these labels (key and value) are returned.`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"slos": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -31,6 +39,16 @@ Datasource for retrieving all SLOs.
 							Description: `A unique, random identifier. This value will also be the name of the resource stored in the API server. This value is read-only.`,
 							Computed:    true,
 						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: `The status of the SLO, eg. whether its recording and alerting rules were created successfully.`,
+							Computed:    true,
+						},
+						"status_message": {
+							Type:        schema.TypeString,
+							Description: `Details about the status of the SLO, eg. the error that occurred when creating its rules.`,
+							Computed:    true,
+						},
 					}),
 				},
 			},
@@ -58,7 +76,11 @@ func datasourceSloRead(ctx context.Context, d *schema.ResourceData, client *slo.
 		return diags
 	}
 
+	labelFilter := d.Get("label_filter").(map[string]interface{})
 	for _, slo := range apiSlos.Slos {
+		if !sloHasLabels(slo, labelFilter) {
+			continue
+		}
 		terraformSlo := convertDatasourceSlo(slo)
 		terraformSlos = append(terraformSlos, terraformSlo)
 	}
@@ -90,9 +112,35 @@ func convertDatasourceSlo(slo slo.SloV00Slo) map[string]interface{} {
 	ret["alerting"] = retAlerting
 	ret["search_expression"] = slo.SearchExpression
 
+	if readOnly := slo.ReadOnly; readOnly != nil {
+		if readOnly.DrillDownDashboardRef != nil {
+			ret["dashboard_uid"] = readOnly.DrillDownDashboardRef.UID
+		}
+		if readOnly.Status != nil {
+			ret["status"] = readOnly.Status.Type
+			ret["status_message"] = readOnly.Status.GetMessage()
+		}
+	}
+
 	return ret
 }
 
+func sloHasLabels(slo slo.SloV00Slo, labels map[string]interface{}) bool {
+	for key, value := range labels {
+		found := false
+		for _, label := range slo.Labels {
+			if label.Key == key && label.Value == value.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func unpackQuery(apiquery slo.SloV00Query) []map[string]interface{} {
 	retQuery := []map[string]interface{}{}
 
@@ -123,6 +171,23 @@ func unpackQuery(apiquery slo.SloV00Query) []map[string]interface{} {
 		retQuery = append(retQuery, query)
 	}
 
+	if apiquery.Type == QueryTypeThreshold {
+		query := map[string]interface{}{"type": QueryTypeThreshold}
+
+		threshold := []map[string]interface{}{}
+		body := map[string]interface{}{
+			"threshold_expression": apiquery.Threshold.ThresholdExpression,
+			"operator":             apiquery.Threshold.Threshold.Operator,
+			"value":                apiquery.Threshold.Threshold.Value,
+			"group_by_labels":      apiquery.Threshold.GroupByLabels,
+		}
+
+		threshold = append(threshold, body)
+		query["threshold"] = threshold
+
+		retQuery = append(retQuery, query)
+	}
+
 	return retQuery
 }
 
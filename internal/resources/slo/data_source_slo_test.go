@@ -39,6 +39,9 @@ func TestAccDataSourceSlo(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.grafana_slos.slos", "slos.0.uuid"),
 					resource.TestCheckResourceAttrSet("data.grafana_slos.slos", "slos.0.name"),
+					resource.TestCheckResourceAttrSet("data.grafana_slos.slos", "slos.0.status"),
+					resource.TestCheckResourceAttrSet("data.grafana_slos.filtered", "slos.0.uuid"),
+					resource.TestCheckResourceAttr("data.grafana_slos.filtered", "slos.0.label.0.key", "custom"),
 				),
 			},
 		},
@@ -74,7 +74,7 @@ Resource manages Grafana SLOs.
 			"query": {
 				Type:        schema.TypeList,
 				Required:    true,
-				Description: `Query describes the indicator that will be measured against the objective. Freeform Query types are currently supported.`,
+				Description: `Query describes the indicator that will be measured against the objective. Freeform, Ratio and Threshold Query types are currently supported.`,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -124,6 +124,39 @@ Resource manages Grafana SLOs.
 								},
 							},
 						},
+						"threshold": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"threshold_expression": {
+										Type:        schema.TypeString,
+										Description: `Expression that is compared to the threshold, eg. a latency percentile computed with histogram_quantile`,
+										Required:    true,
+									},
+									"operator": {
+										Type:         schema.TypeString,
+										Description:  `Operator used to compare the expression to the threshold value. The events for which the comparison is true are successful. Must be one of: "<", "<=", ">", or ">="`,
+										ValidateFunc: validation.StringInSlice([]string{"<", "<=", ">", ">="}, false),
+										Required:     true,
+									},
+									"value": {
+										Type:        schema.TypeFloat,
+										Description: `Threshold value`,
+										Required:    true,
+									},
+									"group_by_labels": {
+										Type:        schema.TypeList,
+										Description: `Defines Group By Labels used for per-label alerting. These appear as variables on SLO dashboards to enable filtering and aggregation. Labels must adhere to Prometheus label name schema - "^[a-zA-Z_][a-zA-Z0-9_]*$"`,
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -245,6 +278,11 @@ Resource manages Grafana SLOs.
 				Optional:    true,
 				Description: "The name of a search expression in Grafana Asserts. This is used in the SLO UI to open the Asserts RCA workbench and in alerts to link to the RCA workbench.",
 			},
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UID of the dashboard generated for the SLO. This can be used to manage the permissions of the dashboard or to link to it.",
+			},
 		},
 	}
 
@@ -480,17 +518,7 @@ func packQuery(query map[string]interface{}) (slo.SloV00Query, error) {
 		ratioquery := query["ratio"].([]interface{})[0].(map[string]interface{})
 		successMetric := ratioquery["success_metric"].(string)
 		totalMetric := ratioquery["total_metric"].(string)
-		groupByLabels := ratioquery["group_by_labels"].([]interface{})
-
-		var labels []string
-
-		for ind := range groupByLabels {
-			if groupByLabels[ind] == nil {
-				labels = append(labels, "")
-				continue
-			}
-			labels = append(labels, groupByLabels[ind].(string))
-		}
+		labels := packGroupByLabels(ratioquery["group_by_labels"].([]interface{}))
 
 		sloQuery := slo.SloV00Query{
 			Ratio: &slo.SloV00RatioQuery{
@@ -508,9 +536,41 @@ func packQuery(query map[string]interface{}) (slo.SloV00Query, error) {
 		return sloQuery, nil
 	}
 
+	if query["type"] == "threshold" {
+		thresholdquery := query["threshold"].([]interface{})[0].(map[string]interface{})
+
+		sloQuery := slo.SloV00Query{
+			Threshold: &slo.SloV00ThresholdQuery{
+				ThresholdExpression: thresholdquery["threshold_expression"].(string),
+				Threshold: slo.SloV00Threshold{
+					Operator: thresholdquery["operator"].(string),
+					Value:    thresholdquery["value"].(float64),
+				},
+				GroupByLabels: packGroupByLabels(thresholdquery["group_by_labels"].([]interface{})),
+			},
+			Type: QueryTypeThreshold,
+		}
+
+		return sloQuery, nil
+	}
+
 	return slo.SloV00Query{}, fmt.Errorf("%s query type not implemented", query["type"])
 }
 
+func packGroupByLabels(groupByLabels []interface{}) []string {
+	var labels []string
+
+	for ind := range groupByLabels {
+		if groupByLabels[ind] == nil {
+			labels = append(labels, "")
+			continue
+		}
+		labels = append(labels, groupByLabels[ind].(string))
+	}
+
+	return labels
+}
+
 func packObjectives(tfobjectives []interface{}) []slo.SloV00Objective {
 	objectives := []slo.SloV00Objective{}
 
@@ -640,6 +700,12 @@ func setTerraformState(d *schema.ResourceData, slo slo.SloV00Slo) {
 	retAlerting := unpackAlerting(slo.Alerting)
 	d.Set("alerting", retAlerting)
 	d.Set("search_expression", slo.SearchExpression)
+
+	dashboardUID := ""
+	if slo.ReadOnly != nil && slo.ReadOnly.DrillDownDashboardRef != nil {
+		dashboardUID = slo.ReadOnly.DrillDownDashboardRef.UID
+	}
+	d.Set("dashboard_uid", dashboardUID)
 }
 
 func apiError(action string, err error) diag.Diagnostics {
@@ -34,6 +34,7 @@ func TestAccResourceSlo(t *testing.T) {
 					testAccSloCheckExists("grafana_slo.test", &slo),
 					resource.TestCheckResourceAttrSet("grafana_slo.test", "id"),
 					resource.TestCheckResourceAttr("grafana_slo.test", "name", randomName),
+					resource.TestCheckResourceAttrSet("grafana_slo.test", "dashboard_uid"),
 					resource.TestCheckResourceAttr("grafana_slo.test", "description", "Terraform Description"),
 					resource.TestCheckResourceAttr("grafana_slo.test", "query.0.type", "freeform"),
 					resource.TestCheckResourceAttr("grafana_slo.test", "query.0.freeform.0.query", "sum(rate(apiserver_request_total{code!=\"500\"}[$__rate_interval])) / sum(rate(apiserver_request_total[$__rate_interval]))"),
@@ -101,6 +102,23 @@ func TestAccResourceSlo(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// Tests the threshold query type
+				Config: testutils.TestAccExample(t, "resources/grafana_slo/resource_threshold.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSloCheckExists("grafana_slo.threshold", &slo),
+					resource.TestCheckResourceAttr("grafana_slo.threshold", "query.0.type", "threshold"),
+					resource.TestCheckResourceAttr("grafana_slo.threshold", "query.0.threshold.0.operator", "<"),
+					resource.TestCheckResourceAttr("grafana_slo.threshold", "query.0.threshold.0.value", "0.5"),
+					resource.TestCheckResourceAttr("grafana_slo.threshold", "query.0.threshold.0.group_by_labels.0", "job"),
+				),
+			},
+			{
+				// Import test (this tests that all fields are read correctly)
+				ResourceName:      "grafana_slo.threshold",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				// Tests Advanced Options
 				Config: testutils.TestAccExample(t, "resources/grafana_slo/resource_ratio_advanced_options.tf"),
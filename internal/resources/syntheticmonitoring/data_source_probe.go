@@ -23,6 +23,7 @@ func dataSourceProbe() *common.DataSource {
 				Required:    true,
 			},
 			"auth_token": nil,
+			"keepers":    nil,
 		}),
 	}
 	return common.NewLegacySDKDataSource(common.CategorySyntheticMonitoring, "grafana_synthetic_monitoring_probe", schema)
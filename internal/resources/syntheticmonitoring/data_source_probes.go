@@ -2,16 +2,28 @@ package syntheticmonitoring
 
 import (
 	"context"
+	"sort"
 
+	sm "github.com/grafana/synthetic-monitoring-agent/pkg/pb/synthetic_monitoring"
 	smapi "github.com/grafana/synthetic-monitoring-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	probeVisibilityPublic  = "public"
+	probeVisibilityPrivate = "private"
 )
 
 func dataSourceProbes() *common.DataSource {
 	schema := &schema.Resource{
-		Description: "Data source for retrieving all probes.",
+		Description: `
+Data source for retrieving all probes.
+
+The probes can be filtered by region, labels and visibility (public or private). The ` + "`ids`" + ` attribute can be used directly as the ` + "`probes`" + ` of a check.
+`,
 		ReadContext: withClient[schema.ReadContextFunc](dataSourceProbesRead),
 		Schema: map[string]*schema.Schema{
 			"filter_deprecated": {
@@ -20,6 +32,25 @@ func dataSourceProbes() *common.DataSource {
 				Optional:    true,
 				Default:     true,
 			},
+			"filter_region": {
+				Type:        schema.TypeString,
+				Description: "If set, only probes in this region (eg. `EMEA`, `AMER`, `APAC`) will be returned.",
+				Optional:    true,
+			},
+			"filter_labels": {
+				Type:        schema.TypeMap,
+				Description: "If set, only probes that have all of these labels will be returned.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"filter_visibility": {
+				Type:         schema.TypeString,
+				Description:  "If set, only public or private probes will be returned. Must be one of `public` or `private`.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{probeVisibilityPublic, probeVisibilityPrivate}, false),
+			},
 			"probes": {
 				Description: "Map of probes with their names as keys and IDs as values.",
 				Type:        schema.TypeMap,
@@ -28,6 +59,14 @@ func dataSourceProbes() *common.DataSource {
 					Type: schema.TypeInt,
 				},
 			},
+			"ids": {
+				Description: "The sorted IDs of the probes. This can be used as the `probes` of a check.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
 	}
 	return common.NewLegacySDKDataSource(common.CategorySyntheticMonitoring, "grafana_synthetic_monitoring_probes", schema)
@@ -40,15 +79,50 @@ func dataSourceProbesRead(ctx context.Context, d *schema.ResourceData, c *smapi.
 		return diag.FromErr(err)
 	}
 
+	filterDeprecated := d.Get("filter_deprecated").(bool)
+	region := d.Get("filter_region").(string)
+	visibility := d.Get("filter_visibility").(string)
+	labels := d.Get("filter_labels").(map[string]interface{})
+
 	probes := make(map[string]interface{}, len(prbs))
+	ids := []int{}
 	for _, p := range prbs {
-		if !p.Deprecated || !d.Get("filter_deprecated").(bool) {
-			probes[p.Name] = p.Id
+		if p.Deprecated && filterDeprecated {
+			continue
+		}
+		if region != "" && p.Region != region {
+			continue
+		}
+		if (visibility == probeVisibilityPublic && !p.Public) || (visibility == probeVisibilityPrivate && p.Public) {
+			continue
 		}
+		if !probeHasLabels(p.Labels, labels) {
+			continue
+		}
+		probes[p.Name] = p.Id
+		ids = append(ids, int(p.Id))
 	}
+	sort.Ints(ids)
 
 	d.SetId("probes")
 	d.Set("probes", probes)
+	d.Set("ids", ids)
 
 	return diags
 }
+
+func probeHasLabels(probeLabels []sm.Label, labels map[string]interface{}) bool {
+	for name, value := range labels {
+		found := false
+		for _, l := range probeLabels {
+			if l.Name == name && l.Value == value.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
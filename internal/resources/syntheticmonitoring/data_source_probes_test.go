@@ -23,6 +23,15 @@ func TestAccDataSourceProbes(t *testing.T) {
 				// We're not checking for deprecated probes here because there may not be any, causing tests to fail.
 				Check: resource.TestCheckResourceAttrSet("data.grafana_synthetic_monitoring_probes.main", "probes.Atlanta"),
 			},
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_synthetic_monitoring_probes/with-filters.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafana_synthetic_monitoring_probes.emea_public", "probes.Paris"),
+					resource.TestCheckNoResourceAttr("data.grafana_synthetic_monitoring_probes.emea_public", "probes.Atlanta"),
+					resource.TestCheckResourceAttrSet("data.grafana_synthetic_monitoring_probes.emea_public", "ids.0"),
+					resource.TestCheckResourceAttrPair("grafana_synthetic_monitoring_check.ping", "probes.#", "data.grafana_synthetic_monitoring_probes.emea_public", "ids.#"),
+				),
+			},
 		},
 	})
 }
@@ -125,6 +125,13 @@ var (
 				MaxItems:    1,
 				Elem:        syntheticMonitoringCheckSettingsScripted,
 			},
+			"browser": {
+				Description: "Settings for browser check. See https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/create-checks/checks/k6-browser/.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        syntheticMonitoringCheckSettingsBrowser,
+			},
 			"grpc": {
 				Description: "Settings for gRPC Health check. The target must be of the form `<host>:<port>`, where the host portion must be a valid hostname or IP address.",
 				Type:        schema.TypeSet,
@@ -145,6 +152,16 @@ var (
 		},
 	}
 
+	syntheticMonitoringCheckSettingsBrowser = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"script": {
+				Description: "The k6 script of the check, using the k6 browser module. The thresholds of the check are set in the options of the script.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+
 	syntheticMonitoringCheckSettingsDNS = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ip_version": syntheticMonitoringCheckIPVersion,
@@ -727,7 +744,7 @@ multiple checks for a single endpoint to check different capabilities.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Suppress diff if it's a multihttp check with a timeout of 5000 (default timeout for those)
 					// and it's being changed to 3000 (default timeout set here).
-					doSuppress := d.Get("settings.0.multihttp.0") != nil || d.Get("settings.0.scripted") != nil
+					doSuppress := d.Get("settings.0.multihttp.0") != nil || d.Get("settings.0.scripted") != nil || d.Get("settings.0.browser") != nil
 					if doSuppress &&
 						old == strconv.Itoa(checkMultiHTTPDefaultTimeout) &&
 						new == strconv.Itoa(checkDefaultTimeout) {
@@ -1129,6 +1146,17 @@ func resourceCheckRead(ctx context.Context, d *schema.ResourceData, c *smapi.Cli
 		settings.Add(map[string]any{
 			"scripted": scripted,
 		})
+	case chk.Settings.Browser != nil:
+		browser := schema.NewSet(
+			schema.HashResource(syntheticMonitoringCheckSettingsBrowser),
+			[]any{},
+		)
+		browser.Add(map[string]any{
+			"script": string(chk.Settings.Browser.Script),
+		})
+		settings.Add(map[string]any{
+			"browser": browser,
+		})
 	case chk.Settings.Grpc != nil:
 		grpc := schema.NewSet(
 			schema.HashResource(syntheticMonitoringCheckSettingsGRPC),
@@ -1198,7 +1226,7 @@ func makeCheck(d *schema.ResourceData) (*sm.Check, error) {
 	}
 
 	timeout := int64(d.Get("timeout").(int))
-	if timeout == checkDefaultTimeout && (settings.Multihttp != nil || settings.Scripted != nil) {
+	if timeout == checkDefaultTimeout && (settings.Multihttp != nil || settings.Scripted != nil || settings.Browser != nil) {
 		timeout = checkMultiHTTPDefaultTimeout
 	}
 
@@ -1566,6 +1594,14 @@ func makeCheckSettings(settings map[string]interface{}) (sm.CheckSettings, error
 		}
 	}
 
+	browser := settings["browser"].(*schema.Set).List()
+	if len(browser) > 0 {
+		b := browser[0].(map[string]interface{})
+		cs.Browser = &sm.BrowserSettings{
+			Script: []byte(b["script"].(string)),
+		}
+	}
+
 	grpc := settings["grpc"].(*schema.Set).List()
 	if len(grpc) > 0 {
 		t := grpc[0].(map[string]interface{})
@@ -439,6 +439,43 @@ func TestAccResourceCheck_scripted(t *testing.T) {
 	})
 }
 
+func TestAccResourceCheck_browser(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	// Find and replace the path.module since it's not available in the test environment
+	scriptFilepathAbs, err := filepath.Abs("../../../examples/resources/grafana_synthetic_monitoring_check")
+	require.NoError(t, err)
+	scriptFileContent, err := os.ReadFile(filepath.Join(scriptFilepathAbs, "browser_script.js"))
+	require.NoError(t, err)
+
+	// Inject random job names to avoid conflicts with other tests
+	jobName := acctest.RandomWithPrefix("browser")
+	nameReplaceMap := map[string]string{
+		`"Validate login"`: strconv.Quote(jobName),
+		"${path.module}":   scriptFilepathAbs,
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_check/browser_basic.tf", nameReplaceMap),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafana_synthetic_monitoring_check.browser", "id"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.browser", "job", jobName),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.browser", "target", "https://test.k6.io/my_messages.php"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.browser", "timeout", "5000"), // browser has a default timeout of 5000
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.browser", "settings.0.browser.0.script", string(scriptFileContent)),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "grafana_synthetic_monitoring_check.browser",
+			},
+		},
+	})
+}
+
 func TestAccResourceCheck_grpc(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
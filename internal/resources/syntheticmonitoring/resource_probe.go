@@ -28,12 +28,15 @@ your Grafana Cloud account. Private probes are instances of the open source
 Grafana Synthetic Monitoring Agent.
 
 * [Official documentation](https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/set-up/set-up-private-probes/)
+
+The probe's auth token can be rotated without recreating the probe (which would remove it from its checks): changing the ` + "`keepers`" + ` resets the token.
 `,
 
 		CreateContext: withClient[schema.CreateContextFunc](resourceProbeCreate),
 		ReadContext:   withClient[schema.ReadContextFunc](resourceProbeRead),
 		UpdateContext: withClient[schema.UpdateContextFunc](resourceProbeUpdate),
 		DeleteContext: withClient[schema.DeleteContextFunc](resourceProbeDelete),
+		CustomizeDiff: resourceProbeCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportProbeStateWithToken,
 		},
@@ -107,6 +110,14 @@ Grafana Synthetic Monitoring Agent.
 				Optional:    true,
 				Default:     false,
 			},
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger the reset of the probe's auth token. The probe keeps its ID, and the previous token stops working.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 
@@ -173,14 +184,12 @@ func resourceProbeRead(ctx context.Context, d *schema.ResourceData, c *smapi.Cli
 	d.Set("region", prb.Region)
 	d.Set("public", prb.Public)
 
-	if len(prb.Labels) > 0 {
-		// Convert []sm.Label into a map before set.
-		labels := make(map[string]string, len(prb.Labels))
-		for _, l := range prb.Labels {
-			labels[l.Name] = l.Value
-		}
-		d.Set("labels", labels)
+	// Convert []sm.Label into a map before set.
+	labels := make(map[string]string, len(prb.Labels))
+	for _, l := range prb.Labels {
+		labels[l.Name] = l.Value
 	}
+	d.Set("labels", labels)
 
 	if prb.Capabilities != nil {
 		d.Set("disable_scripted_checks", prb.Capabilities.DisableScriptedChecks)
@@ -197,9 +206,26 @@ func resourceProbeUpdate(ctx context.Context, d *schema.ResourceData, c *smapi.C
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	if d.HasChange("keepers") {
+		_, token, err := c.ResetProbeToken(ctx, *p)
+		if err != nil {
+			return diag.Errorf("failed to reset the auth token of probe %d: %s", p.Id, err)
+		}
+		d.Set("auth_token", base64.StdEncoding.EncodeToString(token))
+	}
+
 	return resourceProbeRead(ctx, d, c)
 }
 
+// resourceProbeCustomizeDiff marks the auth token as changing when the keepers change, since they trigger its reset.
+func resourceProbeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("keepers") {
+		return d.SetNewComputed("auth_token")
+	}
+	return nil
+}
+
 func resourceProbeDelete(ctx context.Context, d *schema.ResourceData, c *smapi.Client) diag.Diagnostics {
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -59,6 +59,61 @@ func TestAccResourceProbe(t *testing.T) {
 	})
 }
 
+// Test that changing the keepers resets the auth token, without recreating the probe
+func TestAccResourceProbe_rotateToken(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	randomName := acctest.RandomWithPrefix("My Probe")
+	var probeID, authToken string
+	checkRotated := func(s *terraform.State) error {
+		rs := s.RootModule().Resources["grafana_synthetic_monitoring_probe.main"]
+		if rs.Primary.ID != probeID {
+			return fmt.Errorf("expected the probe to keep its ID %s, got %s", probeID, rs.Primary.ID)
+		}
+		if rs.Primary.Attributes["auth_token"] == authToken {
+			return fmt.Errorf("expected the auth token to be reset")
+		}
+		authToken = rs.Primary.Attributes["auth_token"]
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_probe/resource.tf", map[string]string{
+					"Mount Everest": randomName,
+				}),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["grafana_synthetic_monitoring_probe.main"]
+					probeID, authToken = rs.Primary.ID, rs.Primary.Attributes["auth_token"]
+					return nil
+				},
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_probe/resource_rotate_token.tf", map[string]string{
+					"Mount Everest": randomName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					checkRotated,
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_probe.main", "keepers.rotation", "2024-06"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_probe.main", "labels.type", "mountain"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_probe/resource_rotate_token.tf", map[string]string{
+					"Mount Everest": randomName,
+					"2024-06":       "2024-07",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					checkRotated,
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_probe.main", "keepers.rotation", "2024-07"),
+				),
+			},
+		},
+	})
+}
+
 // Test that a probe is recreated if deleted outside the Terraform process
 func TestAccResourceProbe_recreate(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)